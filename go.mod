@@ -4,10 +4,10 @@ go 1.23.0
 
 toolchain go1.24.4
 
-require gopkg.in/yaml.v3 v3.0.1
-
 require (
-	github.com/chzyer/readline v1.5.1 // indirect
-	golang.org/x/sys v0.33.0 // indirect
-	golang.org/x/term v0.32.0 // indirect
+	github.com/chzyer/readline v1.5.1
+	golang.org/x/term v0.32.0
+	gopkg.in/yaml.v3 v3.0.1
 )
+
+require golang.org/x/sys v0.33.0 // indirect
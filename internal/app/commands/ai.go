@@ -0,0 +1,206 @@
+package commands
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"atempo/internal/mcp"
+	"atempo/internal/registry"
+	"atempo/internal/scaffold"
+	"atempo/internal/ui"
+	"atempo/internal/utils"
+)
+
+// AICommand manages the AI context files of a project
+type AICommand struct {
+	*BaseCommand
+	templatesFS embed.FS
+}
+
+// NewAICommand creates a new ai command
+func NewAICommand(ctx *CommandContext, templatesFS embed.FS) *AICommand {
+	return &AICommand{
+		BaseCommand: NewBaseCommand(
+			"ai",
+			"Manage AI context files for a project",
+			"atempo ai [refresh | status] [project]",
+			ctx,
+		),
+		templatesFS: templatesFS,
+	}
+}
+
+// Execute runs the ai command
+func (c *AICommand) Execute(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return usageErrorf("subcommand required. Usage: %s", c.Usage())
+	}
+
+	switch args[0] {
+	case "refresh":
+		return c.refresh(args[1:])
+	case "status":
+		return c.status(args[1:])
+	default:
+		return usageErrorf("unknown ai subcommand: %s. Usage: %s", args[0], c.Usage())
+	}
+}
+
+// refresh re-copies the framework's AI context templates without touching compose files
+func (c *AICommand) refresh(args []string) error {
+	projectPath, err := resolveProjectArg(args)
+	if err != nil {
+		return err
+	}
+
+	ui.Printf("→ Refreshing AI context templates in %s...\n", projectPath)
+
+	framework, err := scaffold.RefreshAIContext(projectPath, c.templatesFS)
+	if err != nil {
+		return fmt.Errorf("failed to refresh AI context: %w", err)
+	}
+
+	fmt.Printf("✅ AI context refreshed from %s templates!\n", framework)
+	ui.Println("💡 docker-compose.yml was not modified; run 'atempo reconfigure' to regenerate it")
+	return nil
+}
+
+// aiStatusSkipDirs are dependency and cache directories ignored when looking for code changes
+var aiStatusSkipDirs = map[string]bool{
+	".git": true, "node_modules": true, "vendor": true, "__pycache__": true, ".venv": true, "storage": true,
+}
+
+// status reports whether the project's AI context exists, which MCP server is
+// installed and whether the application code changed after the context was written
+func (c *AICommand) status(args []string) error {
+	projectPath, err := resolveProjectArg(args)
+	if err != nil {
+		return err
+	}
+
+	if !utils.FileExists(projectPath) {
+		return fmt.Errorf("project directory %s does not exist", projectPath)
+	}
+
+	contextDir := filepath.Join(projectPath, mcp.AIContextDir)
+	serverDir := mcp.ServerDir(projectPath)
+
+	ui.Printf("🤖 AI context for %s\n", projectPath)
+
+	contextFiles, contextModified, err := newestFile(contextDir, map[string]bool{filepath.Base(serverDir): true})
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", contextDir, err)
+	}
+	if contextFiles == 0 {
+		fmt.Printf("  Context:    missing (no files in %s/)\n", mcp.AIContextDir)
+	} else {
+		fmt.Printf("  Context:    %d file(s) in %s/, last written %s\n", contextFiles, mcp.AIContextDir, contextModified.Format("2006-01-02 15:04"))
+	}
+	if utils.FileExists(filepath.Join(projectPath, ".ai")) {
+		fmt.Printf("  ⚠️  Found .ai/ as well; atempo only maintains %s/\n", mcp.AIContextDir)
+	}
+
+	fmt.Printf("  MCP server: %s\n", mcpServerSummary(serverDir))
+
+	codeFiles, codeModified, err := newestFile(filepath.Join(projectPath, "src"), aiStatusSkipDirs)
+	if err != nil {
+		return fmt.Errorf("failed to read application source: %w", err)
+	}
+	if codeFiles == 0 {
+		fmt.Println("  Code:       no files in src/")
+	} else {
+		fmt.Printf("  Code:       last changed %s\n", codeModified.Format("2006-01-02 15:04"))
+	}
+
+	switch {
+	case contextFiles == 0:
+		ui.Println("💡 Run 'atempo ai refresh' to create the AI context")
+	case codeFiles > 0 && codeModified.After(contextModified):
+		fmt.Printf("⚠️  AI context is stale: code changed %s after it was written\n", codeModified.Sub(contextModified).Round(time.Second))
+		ui.Println("💡 Run 'atempo ai refresh' to update it")
+	default:
+		fmt.Println("✅ AI context is up to date")
+	}
+	return nil
+}
+
+// newestFile counts the regular files under root and returns the latest modification
+// time among them, skipping directories named in skip. A missing root has no files.
+func newestFile(root string, skip map[string]bool) (int, time.Time, error) {
+	var count int
+	var newest time.Time
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == root {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if entry.IsDir() {
+			if path != root && skip[entry.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		count++
+		if info.ModTime().After(newest) {
+			newest = info.ModTime()
+		}
+		return nil
+	})
+	return count, newest, err
+}
+
+// mcpServerSummary describes the MCP server installed in serverDir from its package.json
+func mcpServerSummary(serverDir string) string {
+	if !utils.FileExists(filepath.Join(serverDir, "index.js")) {
+		return "not installed"
+	}
+
+	summary := "installed"
+	if data, err := os.ReadFile(filepath.Join(serverDir, "package.json")); err == nil {
+		var pkg struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		}
+		if json.Unmarshal(data, &pkg) == nil && pkg.Name != "" {
+			summary = fmt.Sprintf("%s %s", pkg.Name, pkg.Version)
+		}
+	}
+
+	if !utils.FileExists(filepath.Join(serverDir, "node_modules")) {
+		return summary + " (dependencies missing; run 'npm install' in " + serverDir + ")"
+	}
+	return summary
+}
+
+// resolveProjectArg resolves an optional project argument, defaulting to the current directory
+func resolveProjectArg(args []string) (string, error) {
+	if len(args) > 0 {
+		resolvedPath, err := registry.ResolveProjectPath(args[0])
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve project: %w", err)
+		}
+		return resolvedPath, nil
+	}
+
+	cwd, err := utils.CurrentProjectDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current directory: %w", err)
+	}
+	return cwd, nil
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"atempo/internal/registry"
+	"atempo/internal/scaffold"
+)
+
+// AliasCommand manages alternate names for registered projects
+type AliasCommand struct {
+	*BaseCommand
+}
+
+// NewAliasCommand creates a new alias command
+func NewAliasCommand(ctx *CommandContext) *AliasCommand {
+	return &AliasCommand{
+		BaseCommand: NewBaseCommand(
+			"alias",
+			"Manage alternate names for a project",
+			"atempo alias <add|remove> <project> <alias>",
+			ctx,
+		),
+	}
+}
+
+// Execute runs the alias command
+func (c *AliasCommand) Execute(ctx context.Context, args []string) error {
+	if len(args) != 3 {
+		return usageErrorf("usage: %s", c.Usage())
+	}
+
+	subcommand, projectName, alias := args[0], args[1], args[2]
+
+	reg, err := registry.LoadRegistry()
+	if err != nil {
+		return fmt.Errorf("failed to load registry: %w", err)
+	}
+
+	// Report the canonical name even when the project was given by another alias
+	project, err := reg.FindProject(projectName)
+	if err != nil {
+		return err
+	}
+	projectName = project.Name
+
+	switch subcommand {
+	case "add":
+		if err := scaffold.ValidateProjectName(alias); err != nil {
+			return fmt.Errorf("invalid alias: %w", err)
+		}
+		if err := reg.AddAlias(projectName, alias); err != nil {
+			return fmt.Errorf("failed to add alias: %w", err)
+		}
+		fmt.Printf("✅ '%s' now refers to project '%s'\n", alias, projectName)
+	case "remove":
+		if err := reg.RemoveAlias(projectName, alias); err != nil {
+			return fmt.Errorf("failed to remove alias: %w", err)
+		}
+		fmt.Printf("✅ Removed alias '%s' from project '%s'\n", alias, projectName)
+	default:
+		return usageErrorf("unknown alias subcommand: %s. Usage: %s", subcommand, c.Usage())
+	}
+
+	return nil
+}
@@ -71,7 +71,7 @@ func (c *AuthCommand) Execute(ctx context.Context, args []string) error {
 // handleLogin performs authentication for a provider
 func (c *AuthCommand) handleLogin(args []string) error {
 	if len(args) == 0 {
-		return fmt.Errorf("usage: atempo auth login <provider>\nAvailable providers: %s", c.getProviderNames())
+		return usageErrorf("usage: atempo auth login <provider>\nAvailable providers: %s", c.getProviderNames())
 	}
 
 	provider := args[0]
@@ -87,7 +87,7 @@ func (c *AuthCommand) handleLogin(args []string) error {
 			options.Force = true
 		case "--api-key":
 			if i+1 >= len(args) {
-				return fmt.Errorf("--api-key requires a value")
+				return usageErrorf("--api-key requires a value")
 			}
 			options.APIKey = args[i+1]
 			i++ // Skip the next argument
@@ -107,7 +107,7 @@ func (c *AuthCommand) handleLogin(args []string) error {
 	}
 	
 	if selectedProvider == nil {
-		return fmt.Errorf("unknown provider: %s\nAvailable providers: %s", provider, c.getProviderNames())
+		return usageErrorf("unknown provider: %s\nAvailable providers: %s", provider, c.getProviderNames())
 	}
 
 	fmt.Printf("🔐 Authenticating with %s\n", selectedProvider.Description())
@@ -139,7 +139,7 @@ func (c *AuthCommand) handleLogin(args []string) error {
 // handleLogout removes credentials for a provider
 func (c *AuthCommand) handleLogout(args []string) error {
 	if len(args) == 0 {
-		return fmt.Errorf("usage: atempo auth logout <provider>")
+		return usageErrorf("usage: atempo auth logout <provider>")
 	}
 
 	provider := args[0]
@@ -214,7 +214,7 @@ func (c *AuthCommand) listProviders() error {
 // validateCredentials tests stored credentials for a provider
 func (c *AuthCommand) validateCredentials(args []string) error {
 	if len(args) == 0 {
-		return fmt.Errorf("usage: atempo auth validate <provider>")
+		return usageErrorf("usage: atempo auth validate <provider>")
 	}
 
 	provider := args[0]
@@ -0,0 +1,341 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"atempo/internal/compose"
+	"atempo/internal/docker"
+	"atempo/internal/registry"
+	"atempo/internal/ui"
+)
+
+// defaultBulkConcurrency is how many projects stop-all/start-all handle at once
+const defaultBulkConcurrency = 4
+
+// StopAllCommand stops every running project
+type StopAllCommand struct {
+	*BaseCommand
+}
+
+// NewStopAllCommand creates a new stop-all command
+func NewStopAllCommand(ctx *CommandContext) *StopAllCommand {
+	return &StopAllCommand{
+		BaseCommand: NewBaseCommand(
+			"stop-all",
+			"Stop all running projects",
+			"atempo stop-all [--concurrency N] [--keep-going|--fail-fast]",
+			ctx,
+		),
+	}
+}
+
+// Execute stops the running projects in parallel
+func (c *StopAllCommand) Execute(ctx context.Context, args []string) error {
+	concurrency, args, err := extractConcurrency(args)
+	if err != nil {
+		return err
+	}
+	failFast, args, err := extractFailFast(args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return usageErrorf("unexpected argument '%s'. Usage: %s", args[0], c.Usage())
+	}
+
+	reg, err := registry.LoadRegistry()
+	if err != nil {
+		return fmt.Errorf("failed to load project registry: %w", err)
+	}
+	if err := reg.UpdateAllProjectsStatus(); err != nil {
+		return fmt.Errorf("failed to check project status: %w", err)
+	}
+
+	var running []registry.Project
+	for _, project := range reg.ListProjects() {
+		if project.Status == "running" || project.Status == "partial" {
+			running = append(running, project)
+		}
+	}
+	if len(running) == 0 {
+		ui.Println("✅ No running projects")
+		return nil
+	}
+
+	ui.Printf("→ Stopping %d project(s), %d at a time...\n", len(running), concurrency)
+	return runAcrossProjects(running, concurrency, failFast, "stopped", func(project registry.Project, out *bytes.Buffer) error {
+		return docker.ExecuteWithOutput("stop", project.Path, nil, out)
+	})
+}
+
+// StartAllCommand brings up every registered project (also available as up-all)
+type StartAllCommand struct {
+	*BaseCommand
+}
+
+// NewStartAllCommand creates a new start-all command
+func NewStartAllCommand(ctx *CommandContext) *StartAllCommand {
+	return &StartAllCommand{
+		BaseCommand: NewBaseCommand(
+			"start-all",
+			"Start all registered projects (alias: up-all)",
+			"atempo start-all [--tag <tag>] [--concurrency N] [--keep-going|--fail-fast]",
+			ctx,
+		),
+	}
+}
+
+// Execute starts the registered projects, or those tagged with --tag, in parallel
+func (c *StartAllCommand) Execute(ctx context.Context, args []string) error {
+	concurrency, args, err := extractConcurrency(args)
+	if err != nil {
+		return err
+	}
+	failFast, args, err := extractFailFast(args)
+	if err != nil {
+		return err
+	}
+
+	var tag string
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--tag":
+			if i+1 >= len(args) {
+				return usageErrorf("--tag requires a value")
+			}
+			tag = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--tag="):
+			tag = strings.TrimPrefix(arg, "--tag=")
+		default:
+			positional = append(positional, arg)
+		}
+	}
+	if len(positional) > 0 {
+		return usageErrorf("unexpected argument '%s'. Usage: %s", positional[0], c.Usage())
+	}
+
+	reg, err := registry.LoadRegistry()
+	if err != nil {
+		return fmt.Errorf("failed to load project registry: %w", err)
+	}
+
+	var projects []registry.Project
+	for _, project := range reg.ListProjects() {
+		if tag == "" || projectHasTag(project.Path, tag) {
+			projects = append(projects, project)
+		}
+	}
+	if len(projects) == 0 {
+		if tag != "" {
+			ui.Printf("No projects tagged '%s'. Add \"tags\": [\"%s\"] to a project's atempo.json\n", tag, tag)
+		} else {
+			ui.Println("No projects registered. Create one with 'atempo create <framework>'")
+		}
+		return nil
+	}
+
+	// Projects whose host ports were already claimed by an earlier one are not started
+	collisions := hostPortCollisions(projects)
+
+	ui.Printf("→ Starting %d project(s), %d at a time...\n", len(projects), concurrency)
+	return runAcrossProjects(projects, concurrency, failFast, "started", func(project registry.Project, out *bytes.Buffer) error {
+		if collision, ok := collisions[project.Name]; ok {
+			return fmt.Errorf("skipped: %s", collision)
+		}
+		return docker.ExecuteWithOutput("up", project.Path, nil, out)
+	})
+}
+
+// projectHasTag reports whether a project's atempo.json lists tag under "tags"
+func projectHasTag(projectPath, tag string) bool {
+	config, err := compose.LoadAtempoConfig(projectPath)
+	if err != nil {
+		return false
+	}
+	for _, projectTag := range config.Tags {
+		if projectTag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// hostPortCollisions maps each project that declares a host port already declared
+// by an earlier project in the list to a description of the clash
+func hostPortCollisions(projects []registry.Project) map[string]string {
+	owners := make(map[int]string)
+	collisions := make(map[string]string)
+
+	for _, project := range projects {
+		ports, _, err := registry.ConfiguredPorts(project.Path)
+		if err != nil {
+			continue // docker-compose reports unreadable projects itself
+		}
+
+		for _, port := range ports {
+			if owner, taken := owners[port.External]; taken && owner != project.Name {
+				collisions[project.Name] = fmt.Sprintf("host port %d (%s) is also used by %s", port.External, port.Service, owner)
+				break
+			}
+		}
+		if _, collided := collisions[project.Name]; collided {
+			continue
+		}
+		for _, port := range ports {
+			owners[port.External] = project.Name
+		}
+	}
+	return collisions
+}
+
+// bulkResult is the buffered outcome of an operation on one project
+type bulkResult struct {
+	output  bytes.Buffer
+	err     error
+	skipped bool // Not attempted because an earlier project failed (--fail-fast)
+	done    chan struct{}
+}
+
+// runAcrossProjects runs operation for each project with at most concurrency running
+// at once. Projects are handed to the workers in order. Each project's output is
+// buffered and printed as one block, in project order, so parallel docker-compose
+// runs stay readable. A summary follows. With failFast, projects handed out after a
+// failure are skipped; operations already running finish. Any failure makes the
+// returned error non-nil.
+func runAcrossProjects(projects []registry.Project, concurrency int, failFast bool, verb string, operation func(registry.Project, *bytes.Buffer) error) error {
+	results := make([]*bulkResult, len(projects))
+	for i := range results {
+		results[i] = &bulkResult{done: make(chan struct{})}
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var mu sync.Mutex
+	var stopped bool
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				result := results[i]
+
+				mu.Lock()
+				result.skipped = stopped
+				mu.Unlock()
+
+				if !result.skipped {
+					result.err = operation(projects[i], &result.output)
+					if result.err != nil && failFast {
+						mu.Lock()
+						stopped = true
+						mu.Unlock()
+					}
+				}
+				close(result.done)
+			}
+		}()
+	}
+	go func() {
+		for i := range projects {
+			jobs <- i
+		}
+		close(jobs)
+	}()
+
+	var failed, skipped []string
+	for i, project := range projects {
+		result := results[i]
+		<-result.done
+
+		fmt.Printf("\n%s── %s ──%s\n", ColorCyan, project.Name, ColorReset)
+		fmt.Print(result.output.String())
+		switch {
+		case result.skipped:
+			fmt.Printf("⏭️  %s skipped (--fail-fast)\n", project.Name)
+			skipped = append(skipped, project.Name)
+		case result.err != nil:
+			fmt.Printf("❌ %s: %v\n", project.Name, result.err)
+			failed = append(failed, project.Name)
+		default:
+			fmt.Printf("✅ %s %s\n", project.Name, verb)
+		}
+	}
+	wg.Wait()
+
+	fmt.Printf("\n%d of %d project(s) %s", len(projects)-len(failed)-len(skipped), len(projects), verb)
+	if len(failed) > 0 {
+		fmt.Printf(", %d failed", len(failed))
+	}
+	if len(skipped) > 0 {
+		fmt.Printf(", %d skipped", len(skipped))
+	}
+	fmt.Println()
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed for %d project(s): %s", len(failed), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// extractFailFast removes --fail-fast and --keep-going (the default) from the
+// arguments and reports whether to stop at the first failed project
+func extractFailFast(args []string) (bool, []string, error) {
+	var failFast, keepGoing bool
+	var remaining []string
+	for _, arg := range args {
+		switch arg {
+		case "--fail-fast":
+			failFast = true
+		case "--keep-going":
+			keepGoing = true
+		default:
+			remaining = append(remaining, arg)
+		}
+	}
+	if failFast && keepGoing {
+		return false, nil, usageErrorf("--fail-fast and --keep-going cannot be combined")
+	}
+	return failFast, remaining, nil
+}
+
+// extractConcurrency removes --concurrency N (or --concurrency=N) from the arguments
+func extractConcurrency(args []string) (int, []string, error) {
+	concurrency := defaultBulkConcurrency
+	var remaining []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg != "--concurrency" && !strings.HasPrefix(arg, "--concurrency=") {
+			remaining = append(remaining, arg)
+			continue
+		}
+
+		value := strings.TrimPrefix(arg, "--concurrency=")
+		if arg == "--concurrency" {
+			if i+1 >= len(args) {
+				return 0, nil, usageErrorf("--concurrency requires a number")
+			}
+			value = args[i+1]
+			i++
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return 0, nil, usageErrorf("invalid --concurrency value '%s': expected a positive number", value)
+		}
+		concurrency = n
+	}
+
+	return concurrency, remaining, nil
+}
@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+
+	"atempo/internal/registry"
+)
+
+func TestRunAcrossProjectsFailFastSkipsLaterProjects(t *testing.T) {
+	projects := []registry.Project{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}}
+
+	for run := 0; run < 20; run++ {
+		var mu sync.Mutex
+		var attempted []string
+		err := runAcrossProjects(projects, 1, true, "started", func(project registry.Project, out *bytes.Buffer) error {
+			mu.Lock()
+			attempted = append(attempted, project.Name)
+			mu.Unlock()
+			if project.Name == "b" {
+				return errors.New("boom")
+			}
+			return nil
+		})
+		if err == nil {
+			t.Fatal("expected an error for the failed project")
+		}
+		if len(attempted) != 2 || attempted[0] != "a" || attempted[1] != "b" {
+			t.Fatalf("run %d attempted %v, want [a b]", run, attempted)
+		}
+	}
+}
@@ -0,0 +1,314 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"atempo/internal/docker"
+)
+
+// CompletionCommand prints shell completion scripts
+type CompletionCommand struct {
+	*BaseCommand
+	registry *CommandRegistry
+}
+
+// NewCompletionCommand creates a new completion command
+func NewCompletionCommand(ctx *CommandContext, registry *CommandRegistry) *CompletionCommand {
+	return &CompletionCommand{
+		BaseCommand: NewBaseCommand(
+			"completion",
+			"Print a shell completion script (bash, zsh, fish)",
+			"atempo completion <bash|zsh|fish>",
+			ctx,
+		),
+		registry: registry,
+	}
+}
+
+// completionFlags lists the flags offered for each command
+var completionFlags = map[string][]string{
+	"create":      {"--name", "--from-template", "--clean-on-fail", "--seed", "--no-install", "--no-register", "--only", "--skip", "--set"},
+	"docker":      {"--timeout", "--force-recreate", "--recreate", "--pull", "--open", "-e", "--env", "--user", "--workdir", "--shell", "--all", "--compose-file", "--image-tag", "--no-cache", "--grep", "--grep-v", "--rmi", "--volumes", "--recreate-volumes", "--force", "--format"},
+	"add-service": {"--build", "--name", "--dockerfile", "--context", "--command"},
+	"doctor":      {"--json"},
+	"validate":    {"--strict"},
+	"reconfigure": {"--image-tag", "--compose-version", "--check", "--force", "--merge", "--capture", "--strict", "--validate", "--prune-orphans"},
+	"projects":    {"--refresh", "--names"},
+	"migrate":     {"--fresh", "--seed"},
+	"seed":        {"--class"},
+	"mcp":         {"--timeout"},
+	"status":      {"--wait-healthy", "--timeout"},
+	"watch":       {"--webhook", "--interval"},
+	"logs":        {"--clean", "--clean-all", "--keep"},
+	"describe":    {"--stats", "--logs", "--runtime"},
+	"start-all":   {"--tag", "--concurrency", "--keep-going", "--fail-fast"},
+	"up-all":      {"--tag", "--concurrency", "--keep-going", "--fail-fast"},
+	"stop-all":    {"--concurrency", "--keep-going", "--fail-fast"},
+	"artisan":     {"--project"},
+	"manage":      {"--project"},
+	"register":    {"--name"},
+}
+
+// subcommandCompletions lists the subcommands of commands that take one before the project
+var subcommandCompletions = map[string][]string{
+	"ai":     {"refresh", "status"},
+	"mcp":    {"test"},
+	"alias":  {"add", "remove"},
+	"doctor": {"templates"},
+	"config": {"list", "get", "set", "unset"},
+}
+
+// projectCommands are commands whose positional argument is a project name
+var projectCommands = []string{"describe", "status", "logs", "reconfigure", "remove", "add-service", "docker", "ai", "migrate", "seed", "validate", "services", "upgrade-check", "watch"}
+
+// Execute prints the completion script for the requested shell
+func (c *CompletionCommand) Execute(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return usageErrorf("usage: %s", c.Usage())
+	}
+
+	switch args[0] {
+	case "bash":
+		fmt.Print(c.bashScript())
+	case "zsh":
+		fmt.Print(c.zshScript())
+	case "fish":
+		fmt.Print(c.fishScript())
+	default:
+		return usageErrorf("unsupported shell: %s (expected bash, zsh or fish)", args[0])
+	}
+	return nil
+}
+
+// commandNames returns the sorted names of all top-level commands
+func (c *CompletionCommand) commandNames() []string {
+	names := c.registry.GetCommandNames()
+	names = append(names, "help")
+	sort.Strings(names)
+	return names
+}
+
+// dockerSubcommands returns the sorted docker subcommands
+func (c *CompletionCommand) dockerSubcommands() []string {
+	names := []string{"exec", "services"}
+	for name := range docker.SupportedCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// flagCases renders one shell case branch per command with flags
+func (c *CompletionCommand) flagCases(format string) string {
+	var b strings.Builder
+	for _, command := range sortedKeys(completionFlags) {
+		fmt.Fprintf(&b, format, command, strings.Join(completionFlags[command], " "))
+	}
+	return b.String()
+}
+
+// subcommandCases renders one shell case branch per command with subcommands
+func (c *CompletionCommand) subcommandCases(format string) string {
+	var b strings.Builder
+	for _, command := range sortedKeys(subcommandCompletions) {
+		fmt.Fprintf(&b, format, command, strings.Join(subcommandCompletions[command], " "))
+	}
+	return b.String()
+}
+
+// sortedKeys returns the keys of a completion map in sorted order
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// bashScript returns the bash completion script
+func (c *CompletionCommand) bashScript() string {
+	return fmt.Sprintf(`# bash completion for atempo
+# Load with: source <(atempo completion bash)
+
+_atempo_projects() {
+    atempo projects --names 2>/dev/null
+}
+
+_atempo() {
+    local cur cmd i
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    cmd=""
+    for ((i = 1; i < COMP_CWORD; i++)); do
+        case "${COMP_WORDS[i]}" in
+            -*) ;;
+            *) cmd="${COMP_WORDS[i]}"; break ;;
+        esac
+    done
+
+    if [[ -z "$cmd" ]]; then
+        if [[ "$cur" == -* ]]; then
+            COMPREPLY=($(compgen -W "--quiet -q --cwd -C --help" -- "$cur"))
+        else
+            COMPREPLY=($(compgen -W "%s $(_atempo_projects)" -- "$cur"))
+        fi
+        return
+    fi
+
+    if [[ "$cur" == -* ]]; then
+        case "$cmd" in
+%s        esac
+        return
+    fi
+
+    case "$cmd" in
+        docker)
+            if [[ $((COMP_CWORD - i)) -eq 1 ]]; then
+                COMPREPLY=($(compgen -W "%s" -- "$cur"))
+            else
+                COMPREPLY=($(compgen -W "$(_atempo_projects)" -- "$cur"))
+            fi
+            ;;
+%s        completion)
+            COMPREPLY=($(compgen -W "bash zsh fish" -- "$cur"))
+            ;;
+        %s)
+            COMPREPLY=($(compgen -W "$(_atempo_projects)" -- "$cur"))
+            ;;
+    esac
+}
+
+complete -F _atempo atempo
+`,
+		strings.Join(c.commandNames(), " "),
+		c.flagCases("            %s) COMPREPLY=($(compgen -W \"%s\" -- \"$cur\")) ;;\n"),
+		strings.Join(c.dockerSubcommands(), " "),
+		c.subcommandCases(`        %s)
+            if [[ $((COMP_CWORD - i)) -eq 1 ]]; then
+                COMPREPLY=($(compgen -W "%s" -- "$cur"))
+            else
+                COMPREPLY=($(compgen -W "$(_atempo_projects)" -- "$cur"))
+            fi
+            ;;
+`),
+		strings.Join(projectCommands, "|"),
+	)
+}
+
+// zshScript returns the zsh completion script
+func (c *CompletionCommand) zshScript() string {
+	return fmt.Sprintf(`#compdef atempo
+# zsh completion for atempo
+# Load with: source <(atempo completion zsh)
+
+_atempo() {
+    local -a projects
+    local cmd i
+    projects=(${(f)"$(atempo projects --names 2>/dev/null)"})
+
+    cmd=""
+    for ((i = 2; i < CURRENT; i++)); do
+        if [[ "${words[i]}" != -* ]]; then
+            cmd="${words[i]}"
+            break
+        fi
+    done
+
+    if [[ -z "$cmd" ]]; then
+        if [[ "${words[CURRENT]}" == -* ]]; then
+            compadd -- --quiet -q --cwd -C --help
+        else
+            compadd -- %s $projects
+        fi
+        return
+    fi
+
+    if [[ "${words[CURRENT]}" == -* ]]; then
+        case "$cmd" in
+%s        esac
+        return
+    fi
+
+    case "$cmd" in
+        docker)
+            if (( CURRENT - i == 1 )); then
+                compadd -- %s
+            else
+                compadd -- $projects
+            fi
+            ;;
+%s        completion)
+            compadd -- bash zsh fish
+            ;;
+        %s)
+            compadd -- $projects
+            ;;
+    esac
+}
+
+compdef _atempo atempo
+`,
+		strings.Join(c.commandNames(), " "),
+		c.flagCases("            %s) compadd -- %s ;;\n"),
+		strings.Join(c.dockerSubcommands(), " "),
+		c.subcommandCases(`        %s)
+            if (( CURRENT - i == 1 )); then
+                compadd -- %s
+            else
+                compadd -- $projects
+            fi
+            ;;
+`),
+		strings.Join(projectCommands, "|"),
+	)
+}
+
+// fishScript returns the fish completion script
+func (c *CompletionCommand) fishScript() string {
+	var b strings.Builder
+	b.WriteString("# fish completion for atempo\n")
+	b.WriteString("# Load with: atempo completion fish | source\n\n")
+	b.WriteString("complete -c atempo -f\n")
+	b.WriteString("complete -c atempo -s q -l quiet -d 'Suppress decorative output and colors'\n")
+	b.WriteString("complete -c atempo -s C -l cwd -r -a '(__fish_complete_directories)' -d 'Run as if started in another directory'\n")
+
+	for _, name := range c.commandNames() {
+		description := "Show help"
+		if cmd, exists := c.registry.GetCommand(name); exists {
+			description = cmd.Description()
+		}
+		fmt.Fprintf(&b, "complete -c atempo -n '__fish_use_subcommand' -a %s -d %s\n", name, fishQuote(description))
+	}
+	b.WriteString("complete -c atempo -n '__fish_use_subcommand' -a '(atempo projects --names 2>/dev/null)' -d 'Project'\n")
+
+	fmt.Fprintf(&b, "complete -c atempo -n '__fish_seen_subcommand_from docker; and not __fish_seen_subcommand_from %s' -a '%s'\n",
+		strings.Join(c.dockerSubcommands(), " "), strings.Join(c.dockerSubcommands(), " "))
+	for _, command := range sortedKeys(subcommandCompletions) {
+		subcommands := strings.Join(subcommandCompletions[command], " ")
+		fmt.Fprintf(&b, "complete -c atempo -n '__fish_seen_subcommand_from %s; and not __fish_seen_subcommand_from %s' -a '%s'\n",
+			command, subcommands, subcommands)
+	}
+	b.WriteString("complete -c atempo -n '__fish_seen_subcommand_from completion' -a 'bash zsh fish'\n")
+	fmt.Fprintf(&b, "complete -c atempo -n '__fish_seen_subcommand_from %s' -a '(atempo projects --names 2>/dev/null)' -d 'Project'\n",
+		strings.Join(projectCommands, " "))
+
+	for _, command := range sortedKeys(completionFlags) {
+		for _, flag := range completionFlags[command] {
+			if strings.HasPrefix(flag, "--") {
+				fmt.Fprintf(&b, "complete -c atempo -n '__fish_seen_subcommand_from %s' -l %s\n", command, strings.TrimPrefix(flag, "--"))
+			} else {
+				fmt.Fprintf(&b, "complete -c atempo -n '__fish_seen_subcommand_from %s' -s %s\n", command, strings.TrimPrefix(flag, "-"))
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// fishQuote quotes a string for use in a fish script
+func fishQuote(value string) string {
+	return "'" + strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), "'", `\'`) + "'"
+}
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"strings"
+
+	"atempo/internal/config"
+	"atempo/internal/scaffold"
+)
+
+// ConfigCommand reads and changes user settings in ~/.atempo/config.json
+type ConfigCommand struct {
+	*BaseCommand
+	templatesFS embed.FS
+}
+
+// NewConfigCommand creates a new config command
+func NewConfigCommand(ctx *CommandContext, templatesFS embed.FS) *ConfigCommand {
+	return &ConfigCommand{
+		BaseCommand: NewBaseCommand(
+			"config",
+			"Show or change user settings (e.g. default framework versions)",
+			"atempo config [list | get <key> | set <key> <value> | unset <key>]",
+			ctx,
+		),
+		templatesFS: templatesFS,
+	}
+}
+
+// Execute runs the config command
+func (c *ConfigCommand) Execute(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		args = []string{"list"}
+	}
+
+	settings, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	switch subcommand := args[0]; {
+	case subcommand == "list" && len(args) == 1:
+		if len(settings) == 0 {
+			fmt.Println("No settings. Example: atempo config set defaults.laravel.version 11")
+			return nil
+		}
+		for _, key := range settings.Keys() {
+			fmt.Printf("%s=%s\n", key, settings[key])
+		}
+	case subcommand == "get" && len(args) == 2:
+		value, set := settings[args[1]]
+		if !set {
+			return fmt.Errorf("setting '%s' is not set", args[1])
+		}
+		fmt.Println(value)
+	case subcommand == "set" && len(args) == 3:
+		key, value := args[1], args[2]
+		if err := c.validateSetting(key, value); err != nil {
+			return err
+		}
+		settings[key] = value
+		if err := settings.Save(); err != nil {
+			return err
+		}
+		fmt.Printf("✅ %s=%s\n", key, value)
+	case subcommand == "unset" && len(args) == 2:
+		if _, set := settings[args[1]]; !set {
+			return fmt.Errorf("setting '%s' is not set", args[1])
+		}
+		delete(settings, args[1])
+		if err := settings.Save(); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Removed %s\n", args[1])
+	default:
+		return usageErrorf("usage: %s", c.Usage())
+	}
+	return nil
+}
+
+// validateSetting checks that key is a known setting and value suits it. The only
+// settings so far are defaults.<framework>.version.
+func (c *ConfigCommand) validateSetting(key, value string) error {
+	parts := strings.Split(key, ".")
+	if len(parts) != 3 || parts[0] != "defaults" || parts[2] != "version" {
+		return usageErrorf("unknown setting '%s' (supported: defaults.<framework>.version)", key)
+	}
+
+	framework := parts[1]
+	if _, err := scaffold.LoadMetadata(c.templatesFS, framework); err != nil {
+		return usageErrorf("unknown framework '%s' in '%s'", framework, key)
+	}
+	if err := scaffold.ValidateFrameworkVersion(c.templatesFS, framework, value); err != nil {
+		return fmt.Errorf("invalid default version: %w", err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	
 	"atempo/internal/scaffold"
+	"atempo/internal/ui"
 )
 
 // CreateCommand handles the 'create' command for scaffolding new projects
@@ -24,7 +25,7 @@ func NewCreateCommand(ctx *CommandContext, templatesFS, mcpServersFS embed.FS) *
 		BaseCommand: NewBaseCommand(
 			"create",
 			"Create a new project",
-			"atempo create <framework>[:<version>] [project_name]",
+			"atempo create <framework>[:<version>] [project_name] [--name <name>] [--from-template <file>] [--clean-on-fail] [--seed] [--no-install] [--no-register] [--only|--skip <steps>] [--set key=value]...",
 			ctx,
 		),
 		templatesFS:  templatesFS,
@@ -34,8 +35,13 @@ func NewCreateCommand(ctx *CommandContext, templatesFS, mcpServersFS embed.FS) *
 
 // Execute runs the create command with enhanced real-time progress
 func (c *CreateCommand) Execute(ctx context.Context, args []string) error {
+	args, opts, err := c.parseFlags(args)
+	if err != nil {
+		return err
+	}
+
 	if len(args) < 1 {
-		return fmt.Errorf("usage: %s\nExamples:\n  atempo create laravel my-app     # Laravel latest in ./my-app/\n  atempo create laravel:11 my-app  # Laravel 11 in ./my-app/\n  atempo create laravel            # Laravel latest in current directory", c.Usage())
+		return usageErrorf("usage: %s\nExamples:\n  atempo create laravel my-app     # Laravel latest in ./my-app/\n  atempo create laravel:11 my-app  # Laravel 11 in ./my-app/\n  atempo create laravel            # Laravel latest in current directory\n  atempo create laravel:11 --name my-app  # Laravel 11 in current directory, named my-app\n  atempo create django my-app --from-template stack.json  # Use a team stack's services", c.Usage())
 	}
 
 	// Parse framework and optional version
@@ -51,7 +57,9 @@ func (c *CreateCommand) Execute(ctx context.Context, args []string) error {
 		version = parts[1]
 	} else {
 		framework = frameworkArg
-		version = c.getLatestVersion(framework)
+		if version, err = scaffold.DefaultVersion(c.templatesFS, framework); err != nil {
+			return err
+		}
 	}
 
 	// Parse optional project name
@@ -86,6 +94,11 @@ func (c *CreateCommand) Execute(ctx context.Context, args []string) error {
 		projectName = filepath.Base(projectDir)
 	}
 
+	// An explicit --name overrides the directory-derived project name
+	if opts.Name != "" {
+		projectName = opts.Name
+	}
+
 	// Check authentication for AI features
 	authChecker := NewAuthChecker()
 	isAuthenticated, authStatus := authChecker.GetAuthStatus()
@@ -95,11 +108,11 @@ func (c *CreateCommand) Execute(ctx context.Context, args []string) error {
 	
 	// Show initial project info
 	ShowInfo(fmt.Sprintf("Creating %s %s project: %s", framework, version, projectName))
-	fmt.Printf("%s📁 Location: %s%s\n", ColorBlue, projectDir, ColorReset)
-	fmt.Printf("%s🔐 Auth Status: %s%s\n\n", ColorBlue, authStatus, ColorReset)
+	ui.Printf("%s📁 Location: %s%s\n", ColorBlue, projectDir, ColorReset)
+	ui.Printf("%s🔐 Auth Status: %s%s\n\n", ColorBlue, authStatus, ColorReset)
 	
 	// Run scaffolding with AI-enhanced progress tracking
-	err := c.runScaffoldWithAI(tracker, framework, version, projectName, projectDir, isAuthenticated)
+	err = c.runScaffoldWithAI(tracker, framework, version, projectName, projectDir, isAuthenticated, opts)
 	if err != nil {
 		// Detailed error messages are already logged by the scaffolding process
 		return err
@@ -107,11 +120,20 @@ func (c *CreateCommand) Execute(ctx context.Context, args []string) error {
 
 	// Complete the process
 	tracker.Complete(projectName)
+
+	if opts.NoInstall {
+		fmt.Println("💡 The installer was skipped: this project won't run until its source is in ./src")
+		fmt.Println("   Copy or clone the application into src/, then run 'atempo docker up'")
+	}
+	if opts.NoRegister {
+		fmt.Println("💡 The project was not registered, so it won't appear in 'atempo projects'")
+		fmt.Printf("   Run commands from %s, or keep it with 'atempo register %s'\n", projectDir, projectDir)
+	}
 	return nil
 }
 
 // runScaffoldWithAI runs the scaffolding process with AI-enhanced progress updates
-func (c *CreateCommand) runScaffoldWithAI(tracker *ProgressTracker, framework, version, projectName, projectDir string, isAuthenticated bool) error {
+func (c *CreateCommand) runScaffoldWithAI(tracker *ProgressTracker, framework, version, projectName, projectDir string, isAuthenticated bool, opts scaffold.Options) error {
 	// Step 1: AI-Powered Project Planning
 	tracker.StartStep(1, "AI-Powered Project Planning")
 	tracker.UpdateStep("Gathering project requirements")
@@ -164,9 +186,13 @@ func (c *CreateCommand) runScaffoldWithAI(tracker *ProgressTracker, framework, v
 	tracker.UpdateStep(fmt.Sprintf("Running %s scaffolding process", framework))
 	
 	// Run the actual scaffolding process
-	if err := scaffold.Run(framework, version, c.templatesFS, c.mcpServersFS); err != nil {
+	if err := scaffold.Run(framework, version, c.templatesFS, c.mcpServersFS, opts); err != nil {
 		// Mark the step as failed with a clean error message
 		tracker.ErrorStep(err.Error())
+		// Keep the more specific code when the cause is known (e.g. docker unavailable)
+		if ExitCode(err) == ExitCodeFailure {
+			return &ExitError{Code: ExitCodeScaffoldFailed, Err: err}
+		}
 		return err
 	}
 	
@@ -193,6 +219,126 @@ func (c *CreateCommand) runScaffoldWithAI(tracker *ProgressTracker, framework, v
 	return nil
 }
 
+// parseFlags extracts create flags from the arguments and returns the remaining positionals
+func (c *CreateCommand) parseFlags(args []string) ([]string, scaffold.Options, error) {
+	var opts scaffold.Options
+	var positionals []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--name":
+			if i+1 >= len(args) {
+				return nil, opts, usageErrorf("--name requires a value")
+			}
+			opts.Name = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--name="):
+			opts.Name = strings.TrimPrefix(arg, "--name=")
+		case arg == "--from-template":
+			if i+1 >= len(args) {
+				return nil, opts, usageErrorf("--from-template requires a file path")
+			}
+			opts.FromTemplate = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--from-template="):
+			opts.FromTemplate = strings.TrimPrefix(arg, "--from-template=")
+		case arg == "--clean-on-fail":
+			opts.CleanOnFail = true
+		case arg == "--seed":
+			opts.Seed = true
+		case arg == "--no-install":
+			opts.NoInstall = true
+		case arg == "--no-register":
+			opts.NoRegister = true
+		case arg == "--only" || arg == "--skip" || strings.HasPrefix(arg, "--only=") || strings.HasPrefix(arg, "--skip="):
+			flag, value, hasValue := strings.Cut(arg, "=")
+			if !hasValue {
+				if i+1 >= len(args) {
+					return nil, opts, usageErrorf("%s requires a comma-separated list of steps (%s)", flag, strings.Join(scaffold.Steps, ", "))
+				}
+				value = args[i+1]
+				i++
+			}
+			steps, err := scaffold.ParseSteps(value)
+			if err != nil {
+				return nil, opts, usageErrorf("%s: %v", flag, err)
+			}
+			if flag == "--only" {
+				opts.Only = steps
+			} else {
+				opts.Skip = steps
+			}
+		case arg == "--set" || strings.HasPrefix(arg, "--set="):
+			value, hasValue := strings.CutPrefix(arg, "--set=")
+			if !hasValue {
+				if i+1 >= len(args) {
+					return nil, opts, usageErrorf("--set requires key=value")
+				}
+				value = args[i+1]
+				i++
+			}
+			key, varValue, ok := strings.Cut(value, "=")
+			if !ok {
+				return nil, opts, usageErrorf("invalid --set '%s': expected key=value", value)
+			}
+			if err := scaffold.ValidateTemplateVariable(key); err != nil {
+				return nil, opts, usageErrorf("--set: %v", err)
+			}
+			if opts.Vars == nil {
+				opts.Vars = make(map[string]string)
+			}
+			opts.Vars[key] = varValue
+		case strings.HasPrefix(arg, "-"):
+			return nil, opts, usageErrorf("unknown flag: %s", arg)
+		default:
+			positionals = append(positionals, arg)
+		}
+	}
+
+	if len(opts.Only) > 0 && len(opts.Skip) > 0 {
+		return nil, opts, usageErrorf("--only and --skip cannot be combined")
+	}
+
+	if opts.Name != "" {
+		if err := scaffold.ValidateProjectName(opts.Name); err != nil {
+			return nil, opts, err
+		}
+	}
+
+	// Resolve the template now, since create changes into the project directory
+	if opts.FromTemplate != "" {
+		templatePath, err := filepath.Abs(opts.FromTemplate)
+		if err != nil {
+			return nil, opts, fmt.Errorf("failed to resolve template path: %w", err)
+		}
+		if _, err := os.Stat(templatePath); err != nil {
+			return nil, opts, fmt.Errorf("stack template not found: %s", opts.FromTemplate)
+		}
+		opts.FromTemplate = templatePath
+	}
+
+	if ui.IsTerminal(os.Stdin) {
+		opts.ConfirmRollback = confirmRollback
+	}
+
+	return positionals, opts, nil
+}
+
+// confirmRollback asks whether to remove the files created by a failed scaffold
+func confirmRollback(created []string) bool {
+	fmt.Printf("\nScaffolding failed after creating %d path(s):\n", len(created))
+	for _, path := range created {
+		fmt.Printf("  %s\n", path)
+	}
+	fmt.Print("Remove them? [y/N]: ")
+
+	var response string
+	fmt.Scanln(&response)
+	response = strings.ToLower(response)
+	return response == "y" || response == "yes"
+}
+
 // createDefaultIntent creates a basic project intent when AI features aren't available
 func createDefaultIntent(framework, version, projectName string) *ProjectIntent {
 	return &ProjectIntent{
@@ -210,15 +356,3 @@ func createDefaultIntent(framework, version, projectName string) *ProjectIntent
 		},
 	}
 }
-
-// getLatestVersion returns the latest supported version for a framework
-func (c *CreateCommand) getLatestVersion(framework string) string {
-	switch framework {
-	case "laravel":
-		return "11" // Laravel 11 is the latest LTS
-	case "django":
-		return "5"  // Django 5 is the latest major version
-	default:
-		return "latest"
-	}
-}
\ No newline at end of file
@@ -2,13 +2,21 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
+	"atempo/internal/compose"
 	"atempo/internal/docker"
 	"atempo/internal/registry"
+	"atempo/internal/utils"
+	"atempo/internal/ui"
 )
 
 // DockerCommand handles Docker-related subcommands
@@ -31,7 +39,7 @@ func NewDockerCommand(ctx *CommandContext) *DockerCommand {
 // Execute runs the docker command
 func (c *DockerCommand) Execute(ctx context.Context, args []string) error {
 	if len(args) < 1 {
-		return fmt.Errorf("usage: %s\n\n%s", c.Usage(), c.getDockerUsage())
+		return usageErrorf("usage: %s\n\n%s", c.Usage(), c.getDockerUsage())
 	}
 
 	// Validate Docker installation
@@ -41,60 +49,515 @@ func (c *DockerCommand) Execute(ctx context.Context, args []string) error {
 
 	dockerCmd := args[0]
 	var projectPath string
-	var additionalArgs []string
 
 	// Parse arguments: atempo docker <command> [project_name_or_path] [additional_args...]
-	if len(args) > 1 {
-		// Check if the second argument is a path/name or additional docker args
-		potentialIdentifier := args[1]
-		if strings.HasPrefix(potentialIdentifier, "-") || c.isDockerArg(potentialIdentifier) {
-			// It's a docker argument, use current directory
-			projectPath = ""
-			additionalArgs = args[1:]
-		} else {
-			// It's a project identifier (name or path)
-			resolvedPath, err := registry.ResolveProjectPath(potentialIdentifier)
-			if err != nil {
-				return fmt.Errorf("failed to resolve project: %w", err)
-			}
-			projectPath = resolvedPath
-			if len(args) > 2 {
-				additionalArgs = args[2:]
-			}
+	// Flags may appear before or after the project identifier; the first
+	// positional argument that isn't a flag value is treated as the project.
+	projectIdentifier, additionalArgs := c.splitProjectArg(args[1:])
+
+	// 'pull <service>' inside a project pulls that service instead of naming a project
+	if dockerCmd == "pull" && projectIdentifier != "" && !c.isKnownProject(projectIdentifier) {
+		additionalArgs = append([]string{projectIdentifier}, additionalArgs...)
+		projectIdentifier = ""
+	}
+
+	if projectIdentifier != "" {
+		resolvedPath, err := registry.ResolveProjectPath(projectIdentifier)
+		if err != nil {
+			return fmt.Errorf("failed to resolve project: %w", err)
 		}
+		projectPath = resolvedPath
 	}
 
 	// Check for timeout flag in additional args
 	timeout, filteredArgs := c.parseTimeoutFlag(additionalArgs)
+
+	// --compose-file (repeatable) replaces docker-compose.yml auto-discovery
+	composeFiles, filteredArgs, err := extractComposeFiles(filteredArgs)
+	if err != nil {
+		return err
+	}
+
+	// Compose only accepts --no-cache on 'build'; 'up --no-cache' would fail there
+	if dockerCmd != "build" && contains(filteredArgs, "--no-cache") {
+		return usageErrorf("--no-cache is only supported by 'build'; run 'atempo docker build --no-cache' before 'up'")
+	}
 	
 	// Handle special commands
 	switch dockerCmd {
-	case "exec":
-		return c.handleDockerExec(projectPath, filteredArgs)
-	case "services":
+	case "up":
+		var pullFirst, openBrowser bool
+		filteredArgs, pullFirst, openBrowser = c.applyUpShortcuts(filteredArgs)
+
+		// --recreate-volumes starts from empty project-owned volumes; external ones are kept
+		var recreateVolumes bool
+		recreateVolumes, filteredArgs = extractRecreateVolumes(filteredArgs)
+		if recreateVolumes {
+			var force bool
+			force, filteredArgs = extractForce(filteredArgs)
+			if err := confirmVolumeRemoval(projectPath, composeFiles, force); err != nil {
+				return err
+			}
+			if err := c.runCompose("down", projectPath, []string{"--volumes"}, 0, composeFiles); err != nil {
+				return fmt.Errorf("failed to remove volumes: %w", err)
+			}
+		}
+
+		if pullFirst {
+			if err := c.runCompose("pull", projectPath, nil, 0, composeFiles); err != nil {
+				return fmt.Errorf("failed to pull images: %w", err)
+			}
+		}
+		if err := c.runCompose(dockerCmd, projectPath, filteredArgs, timeout, composeFiles); err != nil {
+			return err
+		}
+		if openBrowser {
+			return c.openAfterUp(projectPath)
+		}
+		return nil
+	case "build", "push":
+		// --image-tag regenerates docker-compose.yml with deterministic image names first
+		imageTag, remaining, err := extractImageTag(filteredArgs)
+		if err != nil {
+			return err
+		}
+		if imageTag != "" {
+			var force bool
+			force, remaining = extractForce(remaining)
+			if err := c.applyImageTag(projectPath, imageTag, force); err != nil {
+				return err
+			}
+		}
+		return c.runCompose(dockerCmd, projectPath, remaining, timeout, composeFiles)
+	case "down":
+		// A bare --rmi removes the project's built images after bringing it down;
+		// '--rmi all|local' is passed through to compose unchanged
+		removeImages := false
+		var downArgs []string
+		for i := 0; i < len(filteredArgs); i++ {
+			arg := filteredArgs[i]
+			if arg == "--rmi" {
+				if i+1 < len(filteredArgs) && (filteredArgs[i+1] == "all" || filteredArgs[i+1] == "local") {
+					downArgs = append(downArgs, arg, filteredArgs[i+1])
+					i++
+				} else {
+					removeImages = true
+				}
+				continue
+			}
+			downArgs = append(downArgs, arg)
+		}
+
+		// --volumes destroys data, so list what goes (and what is kept) and confirm first
+		if hasVolumesFlag(downArgs) {
+			var force bool
+			force, downArgs = extractForce(downArgs)
+			if err := confirmVolumeRemoval(projectPath, composeFiles, force); err != nil {
+				return err
+			}
+		}
+
+		if !removeImages {
+			return c.runCompose(dockerCmd, projectPath, downArgs, timeout, composeFiles)
+		}
+
+		// The image list comes from docker-compose.yml, so it can't honour --compose-file
+		if len(composeFiles) > 0 {
+			return usageErrorf("--compose-file cannot be combined with a bare --rmi; use '--rmi local' instead")
+		}
+
+		// Read the image names before 'down', while docker-compose.yml is known to be valid
+		images, err := docker.BuildImages(projectPath)
+		if err != nil {
+			return err
+		}
+		if err := c.runCompose(dockerCmd, projectPath, downArgs, timeout, composeFiles); err != nil {
+			return err
+		}
+		return docker.RemoveImages(images)
+	case "ps":
+		// --format json|table renders a consistent view; other formats go to compose
+		format, remaining := extractFormat(filteredArgs)
+		if format == "json" || format == "table" {
+			if len(composeFiles) > 0 {
+				return usageErrorf("--compose-file is not supported with 'docker ps --format %s'", format)
+			}
+			return c.handleDockerPs(projectPath, format)
+		}
+		if format != "" {
+			remaining = append(remaining, "--format", format)
+		}
+		return c.runCompose(dockerCmd, projectPath, remaining, timeout, composeFiles)
+	case "logs":
+		// --grep/--grep-v filter the streamed lines here, keeping compose's colors
+		keep, remaining, err := extractGrepFilter(filteredArgs)
+		if err != nil {
+			return err
+		}
+		if keep == nil {
+			return c.runCompose(dockerCmd, projectPath, remaining, timeout, composeFiles)
+		}
+		return docker.ExecuteWithLineFilter(dockerCmd, projectPath, remaining, composeFiles, keep)
+	case "exec", "services":
+		// These read docker-compose.yml directly
+		if len(composeFiles) > 0 {
+			return usageErrorf("--compose-file is not supported with 'docker %s'", dockerCmd)
+		}
+		if dockerCmd == "exec" {
+			return c.handleDockerExec(projectPath, filteredArgs)
+		}
 		return c.handleDockerServices(projectPath)
 	default:
 		// Standard docker-compose command with optional custom timeout
-		if timeout > 0 {
-			return docker.ExecuteWithCustomTimeout(dockerCmd, projectPath, filteredArgs, timeout)
-		}
-		return docker.ExecuteCommand(dockerCmd, projectPath, filteredArgs)
+		return c.runCompose(dockerCmd, projectPath, filteredArgs, timeout, composeFiles)
 	}
 }
 
 // handleDockerExec processes docker exec commands
 func (c *DockerCommand) handleDockerExec(projectPath string, args []string) error {
+	opts, shell, args, err := parseExecFlags(args)
+	if err != nil {
+		return err
+	}
+
 	if len(args) < 1 {
-		return fmt.Errorf("usage: atempo docker exec <service> [command...]\nExample: atempo docker exec app bash")
+		return usageErrorf("usage: atempo docker exec [-e KEY=VALUE...] [--user <user>] [-w <dir>] [--shell <path>] <service|--all> [command...]\nExample: atempo docker exec app bash")
+	}
+
+	if args[0] == "--all" {
+		return c.handleDockerExecAll(projectPath, opts, args[1:])
 	}
 
 	service := args[0]
-	cmdArgs := []string{"bash"} // default to bash
-	if len(args) > 1 {
-		cmdArgs = args[1:]
+	cmdArgs := args[1:]
+	if len(cmdArgs) == 0 {
+		cmdArgs = []string{execShell(projectPath, service, shell)}
 	}
 
-	return docker.ExecuteExecCommand(service, projectPath, cmdArgs)
+	return docker.ExecuteExecCommand(service, projectPath, opts, cmdArgs)
+}
+
+// handleDockerExecAll runs a command in every service and reports per-service results
+func (c *DockerCommand) handleDockerExecAll(projectPath string, opts docker.ExecOptions, cmdArgs []string) error {
+	if len(cmdArgs) == 0 {
+		return usageErrorf("usage: atempo docker exec [project] --all <command...>\nExample: atempo docker exec my-app --all date")
+	}
+
+	results, err := docker.ExecuteExecAll(projectPath, opts, cmdArgs)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	fmt.Println()
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Printf("❌ %s: %v\n", result.Service, result.Err)
+			failed = append(failed, result.Service)
+		} else {
+			fmt.Printf("✅ %s\n", result.Service)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("command failed in %d of %d service(s): %s", len(failed), len(results), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// execShell picks the shell opened when exec is given no command: --shell, then the
+// service's "shell" in atempo.json, then bash
+func execShell(projectPath, service, override string) string {
+	if override != "" {
+		return override
+	}
+	if projectPath == "" {
+		projectPath, _ = utils.CurrentProjectDir()
+	}
+	if config, err := compose.LoadAtempoConfig(projectPath); err == nil && config.Services[service].Shell != "" {
+		return config.Services[service].Shell
+	}
+	return "bash"
+}
+
+// parseExecFlags extracts the leading exec flags that precede the service name:
+// -e/--env KEY=VALUE (repeatable), -u/--user <user>, -w/--workdir <dir> and
+// --shell <path>, which only atempo reads. Anything after the service is left for
+// the container command.
+func parseExecFlags(args []string) (docker.ExecOptions, string, []string, error) {
+	var opts docker.ExecOptions
+	var shell string
+
+	i := 0
+	for ; i < len(args); i++ {
+		arg := args[i]
+		flag, value, hasValue := strings.Cut(arg, "=")
+		if !strings.HasPrefix(flag, "--") {
+			flag, value, hasValue = arg, "", false // Short flags take their value separately
+		}
+
+		switch flag {
+		case "-e", "--env", "-u", "--user", "-w", "--workdir", "--shell":
+		default:
+			return opts, shell, args[i:], nil
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return opts, shell, nil, usageErrorf("%s requires a value", flag)
+			}
+			value = args[i+1]
+			i++
+		}
+
+		switch flag {
+		case "-e", "--env":
+			if err := docker.ValidateEnvAssignment(value); err != nil {
+				return opts, shell, nil, err
+			}
+			opts.Env = append(opts.Env, value)
+		case "-u", "--user":
+			opts.User = value
+		case "-w", "--workdir":
+			opts.Workdir = value
+		case "--shell":
+			shell = value
+		}
+	}
+
+	return opts, shell, args[i:], nil
+}
+
+// runCompose runs a standard docker-compose command with an optional custom timeout.
+// Explicit compose files, when given, replace docker-compose.yml discovery.
+func (c *DockerCommand) runCompose(dockerCmd, projectPath string, args []string, timeout time.Duration, composeFiles []string) error {
+	if len(composeFiles) > 0 {
+		return docker.ExecuteWithComposeFiles(dockerCmd, projectPath, args, composeFiles, timeout)
+	}
+	if timeout > 0 {
+		return docker.ExecuteWithCustomTimeout(dockerCmd, projectPath, args, timeout)
+	}
+	return docker.ExecuteCommand(dockerCmd, projectPath, args)
+}
+
+// extractComposeFiles removes every --compose-file <path> (or --compose-file=<path>)
+// and returns the paths made absolute against the current directory, in order
+func extractComposeFiles(args []string) ([]string, []string, error) {
+	var files []string
+	var remaining []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		var value string
+		switch {
+		case arg == "--compose-file":
+			if i+1 >= len(args) {
+				return nil, nil, usageErrorf("--compose-file requires a path")
+			}
+			value = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--compose-file="):
+			value = strings.TrimPrefix(arg, "--compose-file=")
+		default:
+			remaining = append(remaining, arg)
+			continue
+		}
+
+		absolute, err := filepath.Abs(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to resolve compose file '%s': %w", value, err)
+		}
+		files = append(files, absolute)
+	}
+
+	return files, remaining, nil
+}
+
+// applyImageTag regenerates docker-compose.yml with the given image tag for build services
+func (c *DockerCommand) applyImageTag(projectPath, imageTag string, force bool) error {
+	if projectPath == "" {
+		cwd, err := utils.CurrentProjectDir()
+		if err != nil {
+			return fmt.Errorf("failed to get current directory: %w", err)
+		}
+		projectPath = cwd
+	}
+
+	if err := confirmComposeOverwrite(projectPath, force); err != nil {
+		return err
+	}
+
+	ui.Printf("→ Tagging build images with %s\n", imageTag)
+	if err := compose.GenerateDockerComposeWithOptions(projectPath, compose.GenerateOptions{ImageTag: imageTag}); err != nil {
+		return fmt.Errorf("failed to regenerate docker-compose.yml: %w", err)
+	}
+	return nil
+}
+
+// extractFormat removes --format <value> (or --format=<value>) from the arguments
+func extractFormat(args []string) (string, []string) {
+	var format string
+	var remaining []string
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--format" && i+1 < len(args):
+			format = args[i+1]
+			i++
+		case strings.HasPrefix(args[i], "--format="):
+			format = strings.TrimPrefix(args[i], "--format=")
+		default:
+			remaining = append(remaining, args[i])
+		}
+	}
+	return format, remaining
+}
+
+// handleDockerPs prints the project's containers as clean JSON or an aligned table
+func (c *DockerCommand) handleDockerPs(projectPath, format string) error {
+	containers, err := docker.ListContainers(projectPath)
+	if err != nil {
+		return err
+	}
+
+	if format == "json" {
+		data, err := json.MarshalIndent(containers, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode containers: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "SERVICE\tSTATE\tHEALTH\tPORTS")
+	for _, container := range containers {
+		ports := make([]string, 0, len(container.Ports))
+		for _, port := range container.Ports {
+			ports = append(ports, fmt.Sprintf("%d->%d/%s", port.Published, port.Target, port.Protocol))
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", container.Service, container.State, valueOrDash(container.Health), valueOrDash(strings.Join(ports, ", ")))
+	}
+	return writer.Flush()
+}
+
+// extractForce removes --force from the arguments and reports whether it was present
+func extractForce(args []string) (bool, []string) {
+	force := false
+	var remaining []string
+	for _, arg := range args {
+		if arg == "--force" {
+			force = true
+			continue
+		}
+		remaining = append(remaining, arg)
+	}
+	return force, remaining
+}
+
+// extractGrepFilter removes --grep <regex> or --grep-v <regex> (also in --flag=value
+// form) and returns the line filter they describe, or nil when neither is given
+func extractGrepFilter(args []string) (func(string) bool, []string, error) {
+	var pattern string
+	var invert, found bool
+	var remaining []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		flag, value, hasValue := strings.Cut(arg, "=")
+		if flag != "--grep" && flag != "--grep-v" {
+			remaining = append(remaining, arg)
+			continue
+		}
+		if found {
+			return nil, nil, usageErrorf("use only one of --grep or --grep-v")
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return nil, nil, usageErrorf("%s requires a regular expression", flag)
+			}
+			value = args[i+1]
+			i++
+		}
+		pattern, invert, found = value, flag == "--grep-v", true
+	}
+
+	if !found {
+		return nil, remaining, nil
+	}
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, nil, usageErrorf("invalid --grep pattern '%s': %v", pattern, err)
+	}
+	return docker.GrepFilter(compiled, invert), remaining, nil
+}
+
+// extractRecreateVolumes removes --recreate-volumes and reports whether it was present
+func extractRecreateVolumes(args []string) (bool, []string) {
+	recreate := false
+	var remaining []string
+	for _, arg := range args {
+		if arg == "--recreate-volumes" {
+			recreate = true
+			continue
+		}
+		remaining = append(remaining, arg)
+	}
+	return recreate, remaining
+}
+
+// hasVolumesFlag reports whether 'down' was asked to remove volumes
+func hasVolumesFlag(args []string) bool {
+	for _, arg := range args {
+		if arg == "--volumes" || arg == "-v" {
+			return true
+		}
+	}
+	return false
+}
+
+// confirmVolumeRemoval lists the project-owned volumes that 'down --volumes' will
+// destroy and the external ones it leaves alone, then asks on a terminal. Without
+// a terminal it refuses unless force is set, since the data cannot be recovered.
+func confirmVolumeRemoval(projectPath string, composeFiles []string, force bool) error {
+	volumes, err := docker.ProjectVolumes(projectPath, composeFiles)
+	if err != nil {
+		return err
+	}
+
+	var owned, external []string
+	for _, volume := range volumes {
+		if volume.External {
+			external = append(external, volume.Name)
+		} else {
+			owned = append(owned, volume.Name)
+		}
+	}
+
+	if len(external) > 0 {
+		ui.Printf("→ Keeping external volume(s): %s\n", strings.Join(external, ", "))
+	}
+	if len(owned) == 0 {
+		return nil
+	}
+
+	fmt.Printf("⚠️  The following volume(s) and all their data will be destroyed:\n")
+	for _, name := range owned {
+		fmt.Printf("   - %s\n", name)
+	}
+	if force {
+		return nil
+	}
+
+	if !ui.IsTerminal(os.Stdin) {
+		return fmt.Errorf("refusing to remove volumes without confirmation; use --force to remove them anyway")
+	}
+
+	fmt.Print("Destroy these volumes? [y/N]: ")
+	var response string
+	fmt.Scanln(&response)
+	if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+		return fmt.Errorf("cancelled; no volumes were removed")
+	}
+	return nil
 }
 
 // handleDockerServices lists available services
@@ -102,9 +565,149 @@ func (c *DockerCommand) handleDockerServices(projectPath string) error {
 	return docker.ListServices(projectPath)
 }
 
+// splitProjectArg separates the optional project identifier from docker arguments.
+// Flags (and the values of flags that take one) are kept in order, so both
+// 'up --force-recreate my-app' and 'up my-app --force-recreate' work.
+func (c *DockerCommand) splitProjectArg(args []string) (string, []string) {
+	var project string
+	var remaining []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if strings.HasPrefix(arg, "-") || c.isDockerArg(arg) {
+			remaining = append(remaining, arg)
+			if c.flagTakesValue(arg) && i+1 < len(args) {
+				remaining = append(remaining, args[i+1])
+				i++
+			}
+			continue
+		}
+
+		// A leading positional is always the project (original behaviour); after
+		// flags it's only taken as the project if it names a known project or path
+		if project == "" && (len(remaining) == 0 || c.onlyFlags(remaining) && c.isKnownProject(arg)) {
+			project = arg
+			continue
+		}
+
+		remaining = append(remaining, arg)
+	}
+
+	return project, remaining
+}
+
+// onlyFlags reports whether the arguments consist solely of flags and their values
+func (c *DockerCommand) onlyFlags(args []string) bool {
+	for i := 0; i < len(args); i++ {
+		if !strings.HasPrefix(args[i], "-") {
+			return false
+		}
+		if c.flagTakesValue(args[i]) {
+			i++
+		}
+	}
+	return true
+}
+
+// isKnownProject reports whether an identifier names a registered project or an existing directory
+func (c *DockerCommand) isKnownProject(identifier string) bool {
+	if reg, err := registry.LoadRegistry(); err == nil {
+		if _, err := reg.FindProject(identifier); err == nil {
+			return true
+		}
+	}
+	info, err := os.Stat(identifier)
+	return err == nil && info.IsDir()
+}
+
+// flagTakesValue reports whether a flag consumes the following argument as its value
+func (c *DockerCommand) flagTakesValue(flag string) bool {
+	valueFlags := []string{"--timeout", "--tail", "-t", "--scale", "--since", "--until", "-e", "--env", "-u", "--user", "-w", "--workdir", "--shell", "--image-tag", "--format", "--compose-file", "--grep", "--grep-v"}
+	for _, valueFlag := range valueFlags {
+		if flag == valueFlag {
+			return true
+		}
+	}
+	return false
+}
+
+// applyUpShortcuts expands convenience flags for 'docker up' into compose arguments.
+// It also reports whether a bare --pull was given, meaning images should be pulled
+// before starting ('--pull <policy>' is passed through to compose unchanged), and
+// whether --open asked for the project to be opened in the browser afterwards.
+func (c *DockerCommand) applyUpShortcuts(args []string) ([]string, bool, bool) {
+	var result []string
+	forceRecreate := false
+	pullFirst := false
+	openBrowser := false
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch arg {
+		case "--force-recreate", "--recreate":
+			forceRecreate = true
+		case "--open":
+			openBrowser = true
+		case "--pull":
+			if i+1 < len(args) && isPullPolicy(args[i+1]) {
+				result = append(result, arg, args[i+1])
+				i++
+			} else {
+				pullFirst = true
+			}
+		default:
+			result = append(result, arg)
+		}
+	}
+
+	if forceRecreate {
+		result = append(result, "--force-recreate")
+	}
+
+	return result, pullFirst, openBrowser
+}
+
+// openAfterUp opens the main URL of the registered project at projectPath
+func (c *DockerCommand) openAfterUp(projectPath string) error {
+	if projectPath == "" {
+		cwd, err := utils.CurrentProjectDir()
+		if err != nil {
+			return fmt.Errorf("failed to get current directory: %w", err)
+		}
+		projectPath = cwd
+	}
+
+	reg, err := registry.LoadRegistry()
+	if err != nil {
+		return fmt.Errorf("failed to load project registry: %w", err)
+	}
+
+	for _, project := range reg.ListProjects() {
+		if project.Path == projectPath {
+			targetURL, err := resolveProjectURL(project.Name, nil)
+			if err != nil {
+				return err
+			}
+			return openURL(targetURL)
+		}
+	}
+
+	return fmt.Errorf("--open needs a registered project; %s is not in the registry", projectPath)
+}
+
+// isPullPolicy reports whether a value is a valid compose pull policy
+func isPullPolicy(value string) bool {
+	switch value {
+	case "always", "missing", "never", "build":
+		return true
+	}
+	return false
+}
+
 // isDockerArg checks if a string looks like a Docker argument
 func (c *DockerCommand) isDockerArg(arg string) bool {
-	dockerArgs := []string{"--force-recreate", "--build", "--no-deps", "--remove-orphans", "-V", "--volumes"}
+	dockerArgs := []string{"--force-recreate", "--build", "--no-deps", "--remove-orphans", "-V", "--volumes", "--rmi"}
 	for _, dockerArg := range dockerArgs {
 		if arg == dockerArg {
 			return true
@@ -122,23 +725,56 @@ Usage:
 
 Common Commands:
   up [project]           Start services in detached mode
+                         --force-recreate (or --recreate) recreates containers
+                         --pull pulls the latest images before starting
+                         --open opens the main URL in the browser afterwards
+                         --recreate-volumes starts with empty project-owned volumes
   down [project]         Stop and remove containers  
+                         --rmi also removes the project's built images
+                         --volumes also removes project-owned volumes after
+                         confirming (external volumes are never removed)
   build [project]        Build or rebuild services
+                         --image-tag <tag> sets deterministic image names
+                         (--force regenerates over a hand-edited compose file)
+                         --no-cache rebuilds from scratch (timeout raised to 25m)
+  push [project]         Push built service images (use with --image-tag)
+  pull [project] [svc]   Pull service images with progress (15m timeout, --timeout to change)
   logs [project] [svc]   View output from containers
+                         --grep <regex> shows matching lines (--grep-v: non-matching)
   ps [project]           List containers
+                         --format json|table prints service, state, health and ports
   restart [project]      Restart services
   stop [project]         Stop running containers
   exec <service> [cmd]   Execute command in container
+                         -e KEY=VALUE (repeatable) sets environment variables
+                         --all <cmd> runs the command in every service
   services [project]     List available services
 
 Examples:
   atempo docker up                    # Start services in current directory
   atempo docker up my-laravel-app    # Start services for registered project
   atempo docker up ../myproject      # Start services in relative path
+  atempo docker up my-app --wait --open  # Start, wait for healthchecks, open browser
   atempo docker logs app             # View app container logs
+  atempo docker logs --grep 'ERROR|WARN'  # Only error and warning lines
+  atempo docker pull my-app mysql    # Pull only the mysql image for 'my-app'
   atempo docker exec app bash        # Open bash in app container
   atempo docker exec web python manage.py shell  # Django shell
-  atempo docker down --volumes       # Stop and remove volumes
+  atempo docker exec -e APP_ENV=testing app php artisan test  # Run with extra env
+  atempo docker exec my-app --user root app apt-get update  # Run as root
+  atempo docker exec -w /var/www/src app ls  # Run in another working directory
+  atempo docker exec --shell ash redis  # Open ash instead of bash ("shell" in atempo.json sets a default)
+  atempo docker exec my-app --all date  # Run in every service, report each result
+  atempo docker down --volumes       # Stop and remove project-owned volumes (asks first)
+  atempo docker up --recreate-volumes --force  # Fresh volumes without prompting
+  atempo docker up --force-recreate my-app  # Recreate containers for a project
+  atempo docker up --compose-file docker-compose.yml --compose-file docker-compose.dev.yml
+
+Compose Files:
+  --compose-file <path>  Use this compose file instead of docker-compose.yml
+                         (repeatable; later files override earlier ones)
+                         Not supported by exec, services, ps --format json|table
+                         or a bare down --rmi
 
 Project Resolution:
   - Project name (from registry): 'my-laravel-app'
@@ -157,7 +793,7 @@ func (c *DockerCommand) parseTimeoutFlag(args []string) (time.Duration, []string
 		
 		// Check for --timeout flag
 		if arg == "--timeout" && i+1 < len(args) {
-			if duration, err := c.parseTimeoutValue(args[i+1]); err == nil {
+			if duration, err := parseTimeoutValue(args[i+1]); err == nil {
 				timeout = duration
 				i++ // Skip the next argument (timeout value)
 				continue
@@ -167,7 +803,7 @@ func (c *DockerCommand) parseTimeoutFlag(args []string) (time.Duration, []string
 		// Check for --timeout=value format
 		if strings.HasPrefix(arg, "--timeout=") {
 			value := strings.TrimPrefix(arg, "--timeout=")
-			if duration, err := c.parseTimeoutValue(value); err == nil {
+			if duration, err := parseTimeoutValue(value); err == nil {
 				timeout = duration
 				continue
 			}
@@ -181,7 +817,7 @@ func (c *DockerCommand) parseTimeoutFlag(args []string) (time.Duration, []string
 }
 
 // parseTimeoutValue parses timeout string into duration (supports suffixes like 5m, 30s, etc.)
-func (c *DockerCommand) parseTimeoutValue(value string) (time.Duration, error) {
+func parseTimeoutValue(value string) (time.Duration, error) {
 	// Try parsing as duration first (5m, 30s, etc.)
 	if duration, err := time.ParseDuration(value); err == nil {
 		return duration, nil
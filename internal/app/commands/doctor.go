@@ -0,0 +1,289 @@
+package commands
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"atempo/internal/registry"
+	"atempo/internal/scaffold"
+	"atempo/internal/utils"
+)
+
+// Check statuses reported by the doctor command, ordered by severity
+const (
+	CheckPass = "pass"
+	CheckWarn = "warn"
+	CheckFail = "fail"
+)
+
+// DoctorCheck is the result of a single environment check
+type DoctorCheck struct {
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Detail      string `json:"detail"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// DoctorCommand checks that the local environment is ready for atempo
+type DoctorCommand struct {
+	*BaseCommand
+	templatesFS embed.FS
+}
+
+// NewDoctorCommand creates a new doctor command
+func NewDoctorCommand(ctx *CommandContext, templatesFS embed.FS) *DoctorCommand {
+	return &DoctorCommand{
+		BaseCommand: NewBaseCommand(
+			"doctor",
+			"Check that Docker and other dependencies are ready",
+			"atempo doctor [templates] [--json]",
+			ctx,
+		),
+		templatesFS: templatesFS,
+	}
+}
+
+// Execute runs the doctor command. The exit code reflects the worst check:
+// 0 when everything passes, 1 when there are warnings and ExitCodeChecksFailed on failures.
+func (c *DoctorCommand) Execute(ctx context.Context, args []string) error {
+	templates := false
+	if len(args) > 0 && args[0] == "templates" {
+		templates = true
+		args = args[1:]
+	}
+
+	jsonOutput := false
+	for _, arg := range args {
+		switch arg {
+		case "--json":
+			jsonOutput = true
+		default:
+			return usageErrorf("unknown flag: %s. Usage: %s", arg, c.Usage())
+		}
+	}
+
+	var checks []DoctorCheck
+	if templates {
+		checks = c.checkTemplates()
+	} else {
+		checks = c.runChecks(ctx)
+	}
+
+	if jsonOutput {
+		data, err := json.MarshalIndent(checks, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode checks: %w", err)
+		}
+		fmt.Println(string(data))
+	} else if templates {
+		c.printTable("Atempo Doctor: templates", "✅ All templates resolve from the embedded files", checks)
+	} else {
+		c.printTable("Atempo Doctor", "✅ Environment is ready", checks)
+	}
+
+	switch worstStatus(checks) {
+	case CheckFail:
+		return &ExitError{Code: ExitCodeChecksFailed, Err: fmt.Errorf("doctor checks failed")}
+	case CheckWarn:
+		return &ExitError{Code: ExitCodeFailure, Err: fmt.Errorf("doctor checks passed with warnings")}
+	}
+	return nil
+}
+
+// runChecks runs every environment check in display order
+func (c *DoctorCommand) runChecks(ctx context.Context) []DoctorCheck {
+	return []DoctorCheck{
+		c.checkBinary("docker", "Install Docker Desktop or Docker Engine: https://docs.docker.com/get-docker/", CheckFail),
+		c.checkCompose(),
+		c.checkDockerDaemon(ctx),
+		c.checkBuildx(ctx),
+		c.checkBinary("git", "Install git to show branch information for projects", CheckWarn),
+		c.checkRegistry(),
+	}
+}
+
+// checkBinary verifies that an executable is available on PATH
+func (c *DoctorCommand) checkBinary(name, remediation, missingStatus string) DoctorCheck {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return DoctorCheck{Name: name, Status: missingStatus, Detail: "not found in PATH", Remediation: remediation}
+	}
+	return DoctorCheck{Name: name, Status: CheckPass, Detail: path}
+}
+
+// checkCompose verifies that docker-compose or the 'docker compose' plugin is available
+func (c *DoctorCommand) checkCompose() DoctorCheck {
+	check := DoctorCheck{Name: "docker compose"}
+	if !utils.ComposeAvailable() {
+		check.Status = CheckFail
+		check.Detail = "neither docker-compose nor the 'docker compose' plugin was found"
+		check.Remediation = "Install Docker Compose: https://docs.docker.com/compose/install/"
+		return check
+	}
+
+	check.Status = CheckPass
+	check.Detail = "using '" + strings.Join(utils.ComposeBinary(), " ") + "'"
+	return check
+}
+
+// checkDockerDaemon verifies that the Docker daemon is reachable
+func (c *DoctorCommand) checkDockerDaemon(ctx context.Context) DoctorCheck {
+	check := DoctorCheck{Name: "docker daemon"}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, "docker", "info", "--format", "{{.ServerVersion}}").Output()
+	if err != nil {
+		check.Status = CheckFail
+		check.Detail = "Docker daemon is not reachable"
+		check.Remediation = "Start Docker Desktop or the docker service"
+		return check
+	}
+
+	check.Status = CheckPass
+	check.Detail = "server version " + strings.TrimSpace(string(output))
+	return check
+}
+
+// checkBuildx verifies that docker buildx bake is available for faster builds
+func (c *DoctorCommand) checkBuildx(ctx context.Context) DoctorCheck {
+	check := DoctorCheck{Name: "docker buildx"}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, "docker", "buildx", "version").Output()
+	if err != nil {
+		check.Status = CheckWarn
+		check.Detail = "buildx not available; builds will not use Bake"
+		check.Remediation = "Install the docker buildx plugin: https://docs.docker.com/build/install-buildx/"
+		return check
+	}
+
+	check.Status = CheckPass
+	check.Detail = strings.TrimSpace(string(output))
+	return check
+}
+
+// checkRegistry verifies that the project registry can be loaded
+func (c *DoctorCommand) checkRegistry() DoctorCheck {
+	check := DoctorCheck{Name: "project registry"}
+
+	registryPath, err := registry.GetRegistryPath()
+	if err != nil {
+		check.Status = CheckFail
+		check.Detail = err.Error()
+		check.Remediation = "Make sure your home directory is writable"
+		return check
+	}
+
+	reg, err := registry.LoadRegistry()
+	if err != nil {
+		check.Status = CheckWarn
+		check.Detail = err.Error()
+		check.Remediation = fmt.Sprintf("Fix or remove %s; projects can be re-registered with 'atempo projects'", registryPath)
+		return check
+	}
+
+	check.Status = CheckPass
+	check.Detail = fmt.Sprintf("%d project(s) in %s", len(reg.ListProjects()), registryPath)
+	return check
+}
+
+// checkTemplates reports where each framework template component resolves from.
+// Components found only on the filesystem point to a packaging problem (a missing
+// embed), since installed binaries usually have no templates directory beside them.
+func (c *DoctorCommand) checkTemplates() []DoctorCheck {
+	templates := scaffold.InspectTemplates(c.templatesFS)
+	if len(templates) == 0 {
+		return []DoctorCheck{{
+			Name:        "templates",
+			Status:      CheckFail,
+			Detail:      "no framework templates found, embedded or on the filesystem",
+			Remediation: "Rebuild atempo with the templates embedded, or run it from the repository root",
+		}}
+	}
+
+	var checks []DoctorCheck
+	for _, template := range templates {
+		for _, component := range template.Components {
+			check := DoctorCheck{Name: template.Framework + "/" + component.Name}
+			switch component.Source {
+			case scaffold.SourceEmbedded:
+				check.Status = CheckPass
+				check.Detail = "embedded"
+				if component.FilesystemPath != "" {
+					check.Detail += " (filesystem copy at " + component.FilesystemPath + " is unused)"
+				}
+			case scaffold.SourceFilesystem:
+				check.Status = CheckWarn
+				check.Detail = "filesystem only: " + component.FilesystemPath
+				check.Remediation = "Not embedded in the binary; check the go:embed patterns and rebuild"
+			default:
+				check.Detail = "missing from the embedded files and the filesystem"
+				if component.Name == "atempo.json" {
+					check.Status = CheckFail
+					check.Remediation = fmt.Sprintf("'atempo create %s' will fail until the template has an atempo.json", template.Framework)
+				} else {
+					check.Status = CheckWarn
+					check.Remediation = fmt.Sprintf("'atempo create %s' will skip %s", template.Framework, component.Name)
+				}
+			}
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
+
+// printTable prints the checks as a human readable table
+func (c *DoctorCommand) printTable(title, readyMessage string, checks []DoctorCheck) {
+	fmt.Println(title)
+	fmt.Println()
+
+	for _, check := range checks {
+		var icon, color string
+		switch check.Status {
+		case CheckPass:
+			icon, color = "✓", ColorGreen
+		case CheckWarn:
+			icon, color = "!", ColorYellow
+		default:
+			icon, color = "✗", ColorRed
+		}
+
+		fmt.Printf("  %s%s%s %-18s %s\n", color, icon, ColorReset, check.Name, check.Detail)
+		if check.Remediation != "" && check.Status != CheckPass {
+			fmt.Printf("    %s⎿ %s%s\n", ColorGray, check.Remediation, ColorReset)
+		}
+	}
+
+	fmt.Println()
+	switch worstStatus(checks) {
+	case CheckFail:
+		fmt.Println("❌ Some checks failed")
+	case CheckWarn:
+		fmt.Println("⚠️  Ready, with warnings")
+	default:
+		fmt.Println(readyMessage)
+	}
+}
+
+// worstStatus returns the most severe status among the checks
+func worstStatus(checks []DoctorCheck) string {
+	worst := CheckPass
+	for _, check := range checks {
+		if check.Status == CheckFail {
+			return CheckFail
+		}
+		if check.Status == CheckWarn {
+			worst = CheckWarn
+		}
+	}
+	return worst
+}
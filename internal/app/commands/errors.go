@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"atempo/internal/compose"
+	"atempo/internal/docker"
+	"atempo/internal/registry"
+)
+
+// ExitError carries a specific process exit code for a command failure
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// Error returns the underlying error message
+func (e *ExitError) Error() string {
+	if e.Err == nil {
+		return ""
+	}
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// Exit codes reported for well-known failure classes
+const (
+	ExitCodeFailure           = 1   // Any other error
+	ExitCodeUsage             = 2   // Invalid command, flag or arguments
+	ExitCodeDockerUnavailable = 3   // Docker/Compose missing or daemon not running
+	ExitCodeProjectNotFound   = 4   // Unknown project or missing atempo.json
+	ExitCodeScaffoldFailed    = 5   // 'create' failed while scaffolding
+	ExitCodeChecksFailed      = 6   // 'doctor' found a failing check
+	ExitCodeInterrupted       = 130 // Stopped by Ctrl+C (SIGINT) or SIGTERM
+)
+
+// UsageError reports invalid command-line usage
+type UsageError struct {
+	Message string
+}
+
+// Error returns the usage message
+func (e *UsageError) Error() string {
+	return e.Message
+}
+
+// usageErrorf formats a UsageError
+func usageErrorf(format string, args ...interface{}) error {
+	return &UsageError{Message: fmt.Sprintf(format, args...)}
+}
+
+// ExitCode returns the process exit code for an error returned by a command:
+// 0 for nil, the code of an ExitError, the documented code for a known failure
+// class, and 1 for anything else
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	var usageErr *UsageError
+	switch {
+	case errors.As(err, &usageErr):
+		return ExitCodeUsage
+	case errors.Is(err, docker.ErrDockerNotInstalled), errors.Is(err, docker.ErrComposeNotInstalled), errors.Is(err, docker.ErrDaemonNotRunning):
+		return ExitCodeDockerUnavailable
+	case errors.Is(err, registry.ErrProjectNotFound), errors.Is(err, compose.ErrConfigNotFound):
+		return ExitCodeProjectNotFound
+	case errors.Is(err, docker.ErrInterrupted):
+		return ExitCodeInterrupted
+	}
+	return ExitCodeFailure
+}
+
+// ErrorHint returns follow-up guidance for well-known failure causes, or "" when
+// the error has no specific advice
+func ErrorHint(err error) string {
+	switch {
+	case errors.Is(err, docker.ErrDaemonNotRunning):
+		return "Start Docker Desktop or the docker service, then retry (see 'atempo doctor')"
+	case errors.Is(err, docker.ErrDockerNotInstalled), errors.Is(err, docker.ErrComposeNotInstalled):
+		return "Install Docker with the Compose plugin: https://docs.docker.com/get-docker/"
+	case errors.Is(err, docker.ErrComposeFileNotFound):
+		return "Run 'atempo reconfigure' to generate docker-compose.yml from atempo.json"
+	case errors.Is(err, compose.ErrConfigNotFound):
+		return "Run the command inside an Atempo project, or pass a registered project name"
+	case errors.Is(err, registry.ErrProjectNotFound):
+		return "Run 'atempo projects' to list registered projects"
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"atempo/internal/docker"
+	"atempo/internal/registry"
+	"atempo/internal/utils"
+)
+
+// FrameworkProxyCommand forwards arguments to a framework CLI (artisan, manage.py)
+// inside the project's app container
+type FrameworkProxyCommand struct {
+	*BaseCommand
+	framework string
+	cli       []string
+}
+
+// NewArtisanCommand creates the 'artisan' proxy for Laravel projects
+func NewArtisanCommand(ctx *CommandContext) *FrameworkProxyCommand {
+	return &FrameworkProxyCommand{
+		BaseCommand: NewBaseCommand(
+			"artisan",
+			"Run php artisan in a Laravel project's app container",
+			"atempo artisan [--project <project>] <args...>",
+			ctx,
+		),
+		framework: "laravel",
+		cli:       []string{"php", "artisan"},
+	}
+}
+
+// NewManageCommand creates the 'manage' proxy for Django projects
+func NewManageCommand(ctx *CommandContext) *FrameworkProxyCommand {
+	return &FrameworkProxyCommand{
+		BaseCommand: NewBaseCommand(
+			"manage",
+			"Run python manage.py in a Django project's web container",
+			"atempo manage [--project <project>] <args...>",
+			ctx,
+		),
+		framework: "django",
+		cli:       []string{"python", "manage.py"},
+	}
+}
+
+// Execute forwards all arguments to the framework CLI. The project defaults to the
+// current directory; use --project before the CLI arguments to target another one.
+func (c *FrameworkProxyCommand) Execute(ctx context.Context, args []string) error {
+	var projectPath string
+
+	if len(args) > 0 && (args[0] == "--project" || args[0] == "-p") {
+		if len(args) < 2 {
+			return usageErrorf("%s requires a project name or path", args[0])
+		}
+		resolvedPath, err := registry.ResolveProjectPath(args[1])
+		if err != nil {
+			return fmt.Errorf("failed to resolve project: %w", err)
+		}
+		projectPath = resolvedPath
+		args = args[2:]
+	} else {
+		cwd, err := utils.CurrentProjectDir()
+		if err != nil {
+			return fmt.Errorf("failed to get current directory: %w", err)
+		}
+		projectPath = cwd
+	}
+
+	framework, err := detectProjectFramework(projectPath)
+	if err != nil {
+		return err
+	}
+	if framework != c.framework {
+		return fmt.Errorf("'atempo %s' is for %s projects, but %s is a %s project", c.Name(), c.framework, projectPath, framework)
+	}
+
+	command := append(append([]string{}, c.cli...), args...)
+	return docker.ExecuteExecCommand(docker.GetAppService(framework), projectPath, docker.ExecOptions{}, command)
+}
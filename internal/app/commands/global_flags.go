@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"atempo/internal/ui"
+)
+
+// GlobalOptions holds flags that apply to every command
+type GlobalOptions struct {
+	Quiet bool   // Suppress decorative output and colors
+	Dir   string // Run as if started in this directory (-C, like git)
+}
+
+// ParseGlobalFlags consumes global flags that appear before the command name
+// and returns the parsed options along with the remaining arguments.
+// Example: ["--quiet", "docker", "up"] -> {Quiet: true}, ["docker", "up"]
+func ParseGlobalFlags(args []string) (GlobalOptions, []string, error) {
+	var opts GlobalOptions
+
+	i := 0
+	for ; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			break
+		}
+
+		switch {
+		case arg == "--quiet" || arg == "-q":
+			opts.Quiet = true
+		case arg == "-C" || arg == "--cwd":
+			if i+1 >= len(args) {
+				return opts, nil, usageErrorf("%s requires a directory", arg)
+			}
+			opts.Dir = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--cwd="):
+			opts.Dir = strings.TrimPrefix(arg, "--cwd=")
+		default:
+			// Not a global flag - leave it for the command (e.g. --help)
+			return opts, args[i:], nil
+		}
+	}
+
+	return opts, args[i:], nil
+}
+
+// ApplyGlobalOptions applies parsed global options to the shared output settings
+// and, for -C, changes the working directory so project resolution starts there
+func ApplyGlobalOptions(opts GlobalOptions) error {
+	ui.SetQuiet(opts.Quiet)
+	applyColorSettings()
+
+	if opts.Dir != "" {
+		if err := os.Chdir(opts.Dir); err != nil {
+			return fmt.Errorf("cannot change to directory '%s': %w", opts.Dir, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"atempo/internal/compose"
+	"atempo/internal/ui"
+)
+
+// ListServicesCommand prints the catalog of services that add-service can add
+type ListServicesCommand struct {
+	*BaseCommand
+}
+
+// NewListServicesCommand creates a new list-services command
+func NewListServicesCommand(ctx *CommandContext) *ListServicesCommand {
+	return &ListServicesCommand{
+		BaseCommand: NewBaseCommand(
+			"list-services",
+			"Show the catalog of predefined services for add-service",
+			"atempo list-services",
+			ctx,
+		),
+	}
+}
+
+// Execute prints each predefined service's description, image, ports and environment
+func (c *ListServicesCommand) Execute(ctx context.Context, args []string) error {
+	ui.Println("Predefined services (add with 'atempo add-service <name> [project]'):")
+	ui.Println()
+
+	for _, name := range compose.ListPredefinedServices() {
+		service, ok := compose.GetPredefinedService(name)
+		if !ok {
+			continue
+		}
+
+		env := make([]string, 0, len(service.Environment))
+		for key, value := range service.Environment {
+			env = append(env, fmt.Sprintf("%s=%s", key, value))
+		}
+		sort.Strings(env)
+
+		fmt.Printf("%s%s%s  %s\n", ColorCyan, name, ColorReset, compose.PredefinedServiceDescription(name))
+		fmt.Printf("  Image:       %s\n", valueOrDash(service.Image))
+		fmt.Printf("  Ports:       %s\n", valueOrDash(strings.Join(service.Ports, ", ")))
+		fmt.Printf("  Environment: %s\n", valueOrDash(strings.Join(env, ", ")))
+		fmt.Println()
+	}
+
+	return nil
+}
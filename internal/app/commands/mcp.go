@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"atempo/internal/mcp"
+	"atempo/internal/ui"
+	"atempo/internal/utils"
+)
+
+// defaultMCPTestTimeout bounds the whole 'mcp test' handshake
+const defaultMCPTestTimeout = 30 * time.Second
+
+// MCPCommand inspects a project's MCP server
+type MCPCommand struct {
+	*BaseCommand
+}
+
+// NewMCPCommand creates a new mcp command
+func NewMCPCommand(ctx *CommandContext) *MCPCommand {
+	return &MCPCommand{
+		BaseCommand: NewBaseCommand(
+			"mcp",
+			"Check a project's MCP server",
+			"atempo mcp test [project] [--timeout <duration>]",
+			ctx,
+		),
+	}
+}
+
+// Execute runs the mcp command
+func (c *MCPCommand) Execute(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return usageErrorf("subcommand required. Usage: %s", c.Usage())
+	}
+
+	switch args[0] {
+	case "test":
+		return c.test(ctx, args[1:])
+	default:
+		return usageErrorf("unknown mcp subcommand: %s. Usage: %s", args[0], c.Usage())
+	}
+}
+
+// test starts the project's MCP server, performs the handshake and lists its tools
+func (c *MCPCommand) test(ctx context.Context, args []string) error {
+	timeout := defaultMCPTestTimeout
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--timeout" || strings.HasPrefix(arg, "--timeout="):
+			value := strings.TrimPrefix(arg, "--timeout=")
+			if arg == "--timeout" {
+				if i+1 >= len(args) {
+					return usageErrorf("--timeout requires a value (e.g. 30s)")
+				}
+				value = args[i+1]
+				i++
+			}
+			duration, err := parseTimeoutValue(value)
+			if err != nil {
+				return err
+			}
+			timeout = duration
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectPath, err := resolveProjectArg(positional)
+	if err != nil {
+		return err
+	}
+
+	serverDir := mcp.ServerDir(projectPath)
+	if !utils.FileExists(filepath.Join(serverDir, "index.js")) {
+		return fmt.Errorf("no MCP server found in %s", serverDir)
+	}
+	if !utils.FileExists(filepath.Join(serverDir, "node_modules")) {
+		return fmt.Errorf("MCP server dependencies are missing; run 'npm install' in %s", serverDir)
+	}
+
+	ui.Printf("→ Starting MCP server in %s...\n", serverDir)
+
+	client := mcp.NewMCPClient(serverDir)
+	if err := client.Start(); err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	info, err := client.Initialize(ctx)
+	if err != nil {
+		return mcpTestError("handshake failed", err, client)
+	}
+	fmt.Printf("✅ Handshake OK: %s %s (protocol %s)\n", valueOrDash(info.Name), info.Version, valueOrDash(info.ProtocolVersion))
+
+	tools, err := client.ListTools(ctx)
+	if err != nil {
+		return mcpTestError("listing tools failed", err, client)
+	}
+
+	fmt.Printf("✅ %d tool(s) available\n", len(tools))
+	for _, tool := range tools {
+		if tool.Description != "" {
+			fmt.Printf("  • %s - %s\n", tool.Name, tool.Description)
+		} else {
+			fmt.Printf("  • %s\n", tool.Name)
+		}
+	}
+	return nil
+}
+
+// mcpTestError wraps a failed MCP step, including the server's stderr when it wrote any
+func mcpTestError(step string, err error, client *mcp.MCPClient) error {
+	if stderr := strings.TrimSpace(client.Stderr()); stderr != "" {
+		return fmt.Errorf("MCP %s: %w\nServer output:\n%s", step, err, stderr)
+	}
+	return fmt.Errorf("MCP %s: %w", step, err)
+}
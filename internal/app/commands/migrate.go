@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atempo/internal/compose"
+	"atempo/internal/docker"
+)
+
+// MigrateCommand runs framework database migrations inside the app container
+type MigrateCommand struct {
+	*BaseCommand
+}
+
+// NewMigrateCommand creates a new migrate command
+func NewMigrateCommand(ctx *CommandContext) *MigrateCommand {
+	return &MigrateCommand{
+		BaseCommand: NewBaseCommand(
+			"migrate",
+			"Run database migrations for a project",
+			"atempo migrate [project] [--fresh] [--seed]",
+			ctx,
+		),
+	}
+}
+
+// Execute runs the migrate command
+func (c *MigrateCommand) Execute(ctx context.Context, args []string) error {
+	var fresh, seed bool
+	var positional []string
+
+	for _, arg := range args {
+		switch arg {
+		case "--fresh":
+			fresh = true
+		case "--seed":
+			seed = true
+		default:
+			if strings.HasPrefix(arg, "-") {
+				return usageErrorf("unknown flag: %s. Usage: %s", arg, c.Usage())
+			}
+			positional = append(positional, arg)
+		}
+	}
+
+	projectPath, err := resolveProjectArg(positional)
+	if err != nil {
+		return err
+	}
+
+	framework, err := detectProjectFramework(projectPath)
+	if err != nil {
+		return err
+	}
+
+	var command []string
+	switch framework {
+	case "laravel":
+		command = []string{"php", "artisan", "migrate"}
+		if fresh {
+			command = []string{"php", "artisan", "migrate:fresh"}
+		}
+		if seed {
+			command = append(command, "--seed")
+		}
+	case "django":
+		if fresh || seed {
+			return fmt.Errorf("--fresh and --seed are only supported for Laravel projects")
+		}
+		command = []string{"python", "manage.py", "migrate"}
+	default:
+		return fmt.Errorf("migrations are not supported for framework '%s'", framework)
+	}
+
+	return docker.ExecuteExecCommand(docker.GetAppService(framework), projectPath, docker.ExecOptions{}, command)
+}
+
+// detectProjectFramework returns the framework of a project, preferring atempo.json
+// and falling back to detection from the project files
+func detectProjectFramework(projectPath string) (string, error) {
+	if config, err := compose.LoadAtempoConfig(projectPath); err == nil && config.Framework != "" {
+		return config.Framework, nil
+	}
+
+	framework, err := docker.DetectFramework(projectPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to detect framework: %w", err)
+	}
+	if framework == "unknown" {
+		return "", fmt.Errorf("could not detect the framework for %s", projectPath)
+	}
+	return framework, nil
+}
@@ -6,11 +6,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"atempo/internal/compose"
+	"atempo/internal/docker"
 	"atempo/internal/logger"
 	"atempo/internal/registry"
+	"atempo/internal/ui"
 	"atempo/internal/utils"
 )
 
@@ -25,7 +29,7 @@ func NewReconfigureCommand(ctx *CommandContext) *ReconfigureCommand {
 		BaseCommand: NewBaseCommand(
 			"reconfigure",
 			"Regenerate docker-compose.yml from atempo.json",
-			"atempo reconfigure [project]",
+			"atempo reconfigure [project] [--image-tag <tag>] [--compose-version <version|none>] [--check] [--force] [--merge] [--capture] [--strict] [--validate] [--prune-orphans]",
 			ctx,
 		),
 	}
@@ -34,7 +38,48 @@ func NewReconfigureCommand(ctx *CommandContext) *ReconfigureCommand {
 // Execute runs the reconfigure command
 func (c *ReconfigureCommand) Execute(ctx context.Context, args []string) error {
 	var projectPath string
-	
+	var opts compose.GenerateOptions
+
+	imageTag, args, err := extractImageTag(args)
+	if err != nil {
+		return err
+	}
+	opts.ImageTag = imageTag
+
+	composeVersion, args, err := extractComposeVersion(args)
+	if err != nil {
+		return err
+	}
+	opts.ComposeVersion = composeVersion
+
+	// Boolean flags; --check (alias --diff-only) compares without writing, for CI
+	var check, force, pruneOrphans, validate, capture bool
+	var positional []string
+	for _, arg := range args {
+		switch arg {
+		case "--check", "--diff-only":
+			check = true
+		case "--force":
+			force = true
+		case "--merge":
+			opts.Merge = true
+		case "--strict":
+			opts.Strict = true
+		case "--prune-orphans":
+			pruneOrphans = true
+		case "--validate":
+			validate = true
+		case "--capture":
+			capture = true
+		default:
+			if strings.HasPrefix(arg, "-") {
+				return usageErrorf("unknown flag: %s. Usage: %s", arg, c.Usage())
+			}
+			positional = append(positional, arg)
+		}
+	}
+	args = positional
+
 	if len(args) > 0 {
 		resolvedPath, err := registry.ResolveProjectPath(args[0])
 		if err != nil {
@@ -42,23 +87,298 @@ func (c *ReconfigureCommand) Execute(ctx context.Context, args []string) error {
 		}
 		projectPath = resolvedPath
 	} else {
-		cwd, err := os.Getwd()
+		cwd, err := utils.CurrentProjectDir()
 		if err != nil {
 			return fmt.Errorf("failed to get current directory: %w", err)
 		}
 		projectPath = cwd
 	}
 
-	fmt.Printf("→ Regenerating docker-compose.yml from atempo.json in %s...\n", projectPath)
-	
-	if err := compose.GenerateDockerCompose(projectPath); err != nil {
+	// --validate refuses to write a file docker-compose would reject at 'up'
+	if validate {
+		issues, err := compose.CheckConfig(projectPath)
+		if err != nil {
+			return fmt.Errorf("atempo.json is invalid: %w", err)
+		}
+		if errorCount := printConfigIssues(issues); errorCount > 0 {
+			return fmt.Errorf("%w (%d found); docker-compose.yml was not written", compose.ErrInvalidConfig, errorCount)
+		}
+	}
+
+	if check {
+		return c.checkDockerCompose(projectPath, opts)
+	}
+
+	// --capture moves env vars and volumes added to generated services into atempo.json.
+	// The original is kept so a declined overwrite leaves both files as they were.
+	var originalConfig []byte
+	if capture {
+		var err error
+		if originalConfig, err = c.captureManualAdditions(projectPath, opts); err != nil {
+			return err
+		}
+	}
+
+	// --merge keeps hand-added services, so adding sidecars alone needs no confirmation;
+	// hand edits to generated services are still replaced. Nothing is lost when the
+	// capture accounted for every edit.
+	capturedAll := false
+	if originalConfig != nil {
+		capturedAll, _ = compose.MatchesGenerated(projectPath, opts)
+	}
+	if opts.Merge {
+		ui.Println("→ Keeping services, volumes and networks that atempo.json does not define")
+	} else if capturedAll {
+		ui.Println("→ Every hand edit was captured into atempo.json")
+	} else if err := confirmComposeOverwrite(projectPath, force); err != nil {
+		if originalConfig != nil {
+			if restoreErr := os.WriteFile(filepath.Join(projectPath, "atempo.json"), originalConfig, 0644); restoreErr != nil {
+				return fmt.Errorf("%v; restoring atempo.json also failed: %w", err, restoreErr)
+			}
+			ui.Println("→ Restored atempo.json; nothing was captured")
+		}
+		return err
+	}
+
+	ui.Printf("→ Regenerating docker-compose.yml from atempo.json in %s...\n", projectPath)
+
+	// Remember the old services and container names to spot removals and leftovers
+	previous, _ := docker.ServiceContainers(projectPath)
+
+	if err := compose.GenerateDockerComposeWithOptions(projectPath, opts); err != nil {
 		return fmt.Errorf("failed to regenerate docker-compose.yml: %w", err)
 	}
 
 	fmt.Println("✅ docker-compose.yml regenerated successfully!")
+
+	current, err := docker.ServiceContainers(projectPath)
+	if err != nil {
+		return err
+	}
+
+	var renamed, currentNames, removedServices []string
+	for service, name := range current {
+		currentNames = append(currentNames, name)
+		if old, kept := previous[service]; kept && old != "" && old != name {
+			renamed = append(renamed, old)
+		}
+	}
+	for service := range previous {
+		if _, kept := current[service]; !kept {
+			removedServices = append(removedServices, service)
+		}
+	}
+	sort.Strings(removedServices)
+
+	if err := c.handleRemovedServices(projectPath, removedServices, pruneOrphans); err != nil {
+		return err
+	}
+	return c.handleStaleContainers(projectPath, renamed, currentNames)
+}
+
+// handleRemovedServices reports services that are no longer generated and, with
+// --prune-orphans, removes their containers so they stop running right away
+func (c *ReconfigureCommand) handleRemovedServices(projectPath string, services []string, prune bool) error {
+	if len(services) == 0 {
+		return nil
+	}
+
+	fmt.Printf("➖ Removed service(s): %s\n", strings.Join(services, ", "))
+	if !prune {
+		ui.Println("💡 Their containers keep running until 'atempo docker down'; use --prune-orphans to remove them now")
+		return nil
+	}
+
+	removed, err := docker.RemoveServiceContainers(projectPath, services)
+	if err != nil {
+		return err
+	}
+	if len(removed) == 0 {
+		fmt.Println("✅ No containers to prune")
+	} else {
+		fmt.Printf("✅ Pruned %d container(s)\n", len(removed))
+	}
 	return nil
 }
 
+// handleStaleContainers offers to remove containers left behind under services' old
+// names (previousNames) and warns about current names already taken by another project (e.g. the
+// original of a cloned project), which would fail 'up' with "name already in use"
+func (c *ReconfigureCommand) handleStaleContainers(projectPath string, previousNames, currentNames []string) error {
+	leftovers, conflicts := docker.FindStaleContainers(projectPath, previousNames, currentNames)
+
+	if len(conflicts) > 0 {
+		fmt.Println("⚠️  These container names are already used by another project:")
+		for _, container := range conflicts {
+			fmt.Printf("  • %s (%s)\n", container.Name, container.WorkingDir)
+		}
+		ui.Println("💡 Set a different \"name\" in atempo.json and run 'atempo reconfigure' again")
+	}
+
+	if len(leftovers) == 0 {
+		return nil
+	}
+
+	fmt.Println("⚠️  Containers from the previous project name are still present:")
+	for _, container := range leftovers {
+		fmt.Printf("  • %s\n", container.Name)
+	}
+
+	if !ui.IsTerminal(os.Stdin) {
+		ui.Println("💡 Remove them with 'docker rm -f <name>' before 'atempo docker up'")
+		return nil
+	}
+
+	fmt.Print("Remove these containers? [y/N]: ")
+	var response string
+	fmt.Scanln(&response)
+	if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+		return nil
+	}
+	return docker.RemoveContainers(leftovers)
+}
+
+// captureManualAdditions records the environment variables and volumes added by hand
+// to generated services in atempo.json, so regenerating keeps them. It returns the
+// previous atempo.json content, or nil when nothing was captured.
+func (c *ReconfigureCommand) captureManualAdditions(projectPath string, opts compose.GenerateOptions) ([]byte, error) {
+	additions, err := compose.FindManualAdditions(projectPath, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare docker-compose.yml with atempo.json: %w", err)
+	}
+	if len(additions) == 0 {
+		ui.Println("→ No environment or volume additions to capture")
+		return nil, nil
+	}
+
+	original, err := os.ReadFile(filepath.Join(projectPath, "atempo.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read atempo.json: %w", err)
+	}
+	if err := compose.CaptureManualAdditions(projectPath, additions); err != nil {
+		return nil, fmt.Errorf("failed to capture additions into atempo.json: %w", err)
+	}
+	for _, addition := range additions {
+		fmt.Printf("📥 Captured %s\n", addition)
+	}
+	fmt.Printf("✅ Captured %d addition(s) into atempo.json\n", len(additions))
+	return original, nil
+}
+
+// printConfigIssues prints the problems CheckConfig found in atempo.json and
+// returns how many are errors
+func printConfigIssues(issues []compose.ConfigIssue) int {
+	var errorCount int
+	for _, issue := range issues {
+		if issue.Severity == compose.SeverityError {
+			fmt.Printf("❌ %s\n", issue.Message)
+			errorCount++
+		} else {
+			fmt.Printf("⚠️  %s\n", issue.Message)
+		}
+	}
+	return errorCount
+}
+
+// checkDockerCompose fails with a diff when docker-compose.yml differs from what
+// atempo.json would generate. Nothing is written.
+func (c *ReconfigureCommand) checkDockerCompose(projectPath string, opts compose.GenerateOptions) error {
+	ui.Printf("→ Checking docker-compose.yml against atempo.json in %s...\n", projectPath)
+
+	expected, _, err := compose.RenderDockerCompose(projectPath, opts)
+	if err != nil {
+		return fmt.Errorf("failed to generate docker-compose.yml: %w", err)
+	}
+
+	composePath := filepath.Join(projectPath, "docker-compose.yml")
+	current, err := os.ReadFile(composePath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read docker-compose.yml: %w", err)
+	}
+
+	diff := utils.LineDiff("docker-compose.yml", "docker-compose.yml (from atempo.json)", string(current), expected)
+	if diff == "" {
+		fmt.Println("✅ docker-compose.yml is up to date")
+		return nil
+	}
+
+	fmt.Print(diff)
+	return &ExitError{Code: 1, Err: fmt.Errorf("docker-compose.yml is out of date; run 'atempo reconfigure' and commit the result")}
+}
+
+// confirmComposeOverwrite guards regeneration against losing hand edits to
+// docker-compose.yml. It asks on a terminal and fails otherwise, unless force is set.
+func confirmComposeOverwrite(projectPath string, force bool) error {
+	reason, err := compose.DetectManualEdits(projectPath)
+	if err != nil || reason == "" || force {
+		return err
+	}
+
+	fmt.Printf("⚠️  %s\n", reason)
+	ui.Println("💡 Move custom settings into atempo.json so they survive regeneration")
+
+	if !ui.IsTerminal(os.Stdin) {
+		return fmt.Errorf("refusing to overwrite hand-edited docker-compose.yml; use --force to regenerate anyway")
+	}
+
+	fmt.Print("Overwrite docker-compose.yml and lose these edits? [y/N]: ")
+	var response string
+	fmt.Scanln(&response)
+	if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+		return fmt.Errorf("cancelled; docker-compose.yml was not modified")
+	}
+	return nil
+}
+
+// extractImageTag removes --image-tag <tag> (or --image-tag=<tag>) from the arguments
+func extractImageTag(args []string) (string, []string, error) {
+	var imageTag string
+	var remaining []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--image-tag":
+			if i+1 >= len(args) {
+				return "", nil, usageErrorf("--image-tag requires a value")
+			}
+			imageTag = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--image-tag="):
+			imageTag = strings.TrimPrefix(arg, "--image-tag=")
+		default:
+			remaining = append(remaining, arg)
+		}
+	}
+
+	return imageTag, remaining, nil
+}
+
+// extractComposeVersion removes --compose-version <version> (or --compose-version=<version>)
+// from the arguments
+func extractComposeVersion(args []string) (string, []string, error) {
+	var version string
+	var remaining []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--compose-version":
+			if i+1 >= len(args) {
+				return "", nil, usageErrorf("--compose-version requires a value (e.g. 3.8 or none)")
+			}
+			version = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--compose-version="):
+			version = strings.TrimPrefix(arg, "--compose-version=")
+		default:
+			remaining = append(remaining, arg)
+		}
+	}
+
+	return version, remaining, nil
+}
+
 // AddServiceCommand adds a predefined service to a project
 type AddServiceCommand struct {
 	*BaseCommand
@@ -70,7 +390,7 @@ func NewAddServiceCommand(ctx *CommandContext) *AddServiceCommand {
 		BaseCommand: NewBaseCommand(
 			"add-service",
 			"Add predefined services (minio, elasticsearch, etc.)",
-			"atempo add-service <service_type> [project]",
+			"atempo add-service <service_type> [project]\n       atempo add-service --build --name <name> --dockerfile <path> [--context <dir>] [--command <cmd>] [project]",
 			ctx,
 		),
 	}
@@ -78,12 +398,19 @@ func NewAddServiceCommand(ctx *CommandContext) *AddServiceCommand {
 
 // Execute runs the add-service command
 func (c *AddServiceCommand) Execute(ctx context.Context, args []string) error {
+	for _, arg := range args {
+		if arg == "--build" {
+			return c.addBuildService(args)
+		}
+	}
+
 	if len(args) < 1 {
 		fmt.Println("Usage: atempo add-service <service_type> [project]")
 		fmt.Println("\nAvailable services:")
 		for _, service := range compose.ListPredefinedServices() {
-			fmt.Printf("  %s\n", service)
+			fmt.Printf("  %-14s %s\n", service, compose.PredefinedServiceDescription(service))
 		}
+		fmt.Println("\nRun 'atempo list-services' for images, ports and environment")
 		return fmt.Errorf("service type required")
 	}
 
@@ -97,21 +424,119 @@ func (c *AddServiceCommand) Execute(ctx context.Context, args []string) error {
 		}
 		projectPath = resolvedPath
 	} else {
-		cwd, err := os.Getwd()
+		cwd, err := utils.CurrentProjectDir()
 		if err != nil {
 			return fmt.Errorf("failed to get current directory: %w", err)
 		}
 		projectPath = cwd
 	}
 
-	fmt.Printf("→ Adding %s service to project...\n", serviceType)
+	ui.Printf("→ Adding %s service to project...\n", serviceType)
+
+	if serviceType == "traefik" {
+		return c.addTraefik(projectPath)
+	}
 	
 	if err := compose.AddPredefinedService(projectPath, serviceType); err != nil {
 		return fmt.Errorf("failed to add service: %w", err)
 	}
 
 	fmt.Printf("✅ %s service added to atempo.json\n", serviceType)
-	fmt.Println("Run 'atempo reconfigure' to update docker-compose.yml")
+	ui.Println("Run 'atempo reconfigure' to update docker-compose.yml")
+	return nil
+}
+
+// addTraefik adds the traefik reverse proxy and reports the routes it configured
+func (c *AddServiceCommand) addTraefik(projectPath string) error {
+	routes, err := compose.AddTraefikService(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to add service: %w", err)
+	}
+
+	fmt.Println("✅ traefik service added to atempo.json")
+	fmt.Printf("  Dashboard: http://localhost:%d\n", compose.TraefikDashboardPort)
+	if len(routes) == 0 {
+		ui.Println("  No web services found to route; add traefik labels to services manually")
+	}
+	for _, route := range routes {
+		fmt.Printf("  %s: http://%s:%d (container port %d)\n", route.Service, route.Host, compose.TraefikHTTPPort, route.Port)
+	}
+	ui.Println("Run 'atempo reconfigure' to update docker-compose.yml")
+	return nil
+}
+
+// addBuildService handles 'add-service --build' for custom Dockerfile-based services
+func (c *AddServiceCommand) addBuildService(args []string) error {
+	var serviceName, dockerfile, buildContext, command, projectArg string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch arg {
+		case "--build":
+			continue
+		case "--name", "--dockerfile", "--context", "--command":
+			if i+1 >= len(args) {
+				return usageErrorf("%s requires a value", arg)
+			}
+			value := args[i+1]
+			i++
+			switch arg {
+			case "--name":
+				serviceName = value
+			case "--dockerfile":
+				dockerfile = value
+			case "--context":
+				buildContext = value
+			case "--command":
+				command = value
+			}
+		default:
+			if strings.HasPrefix(arg, "-") {
+				return usageErrorf("unknown flag: %s", arg)
+			}
+			projectArg = arg
+		}
+	}
+
+	if serviceName == "" {
+		return fmt.Errorf("--name is required.\nUsage: atempo add-service --build --name worker --dockerfile infra/docker/worker.Dockerfile")
+	}
+	if dockerfile == "" {
+		dockerfile = fmt.Sprintf("infra/docker/%s.Dockerfile", serviceName)
+	}
+
+	var projectPath string
+	if projectArg != "" {
+		resolvedPath, err := registry.ResolveProjectPath(projectArg)
+		if err != nil {
+			return fmt.Errorf("failed to resolve project: %w", err)
+		}
+		projectPath = resolvedPath
+	} else {
+		cwd, err := utils.CurrentProjectDir()
+		if err != nil {
+			return fmt.Errorf("failed to get current directory: %w", err)
+		}
+		projectPath = cwd
+	}
+
+	ui.Printf("→ Adding build service '%s' to project...\n", serviceName)
+
+	var serviceCommand interface{}
+	if command != "" {
+		serviceCommand = command
+	}
+
+	created, err := compose.AddBuildService(projectPath, serviceName, dockerfile, buildContext, serviceCommand)
+	if err != nil {
+		return fmt.Errorf("failed to add service: %w", err)
+	}
+
+	fmt.Printf("✅ %s build service added to atempo.json\n", serviceName)
+	if created {
+		fmt.Printf("📄 Starter Dockerfile created at %s\n", dockerfile)
+	}
+	ui.Println("Run 'atempo reconfigure' to update docker-compose.yml")
 	return nil
 }
 
@@ -126,22 +551,80 @@ func NewLogsCommand(ctx *CommandContext) *LogsCommand {
 		BaseCommand: NewBaseCommand(
 			"logs",
 			"View setup logs for a project",
-			"atempo logs <project_name>",
+			"atempo logs <project_name> [--clean [--keep N]] | atempo logs --clean-all [--keep N]",
 			ctx,
 		),
 	}
 }
 
+// defaultLogsKeep is how many setup logs per project --clean keeps by default
+const defaultLogsKeep = 5
+
 // Execute runs the logs command
 func (c *LogsCommand) Execute(ctx context.Context, args []string) error {
+	var clean, cleanAll bool
+	keep := defaultLogsKeep
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--clean":
+			clean = true
+		case arg == "--clean-all":
+			cleanAll = true
+		case arg == "--keep" || strings.HasPrefix(arg, "--keep="):
+			value := strings.TrimPrefix(arg, "--keep=")
+			if arg == "--keep" {
+				if i+1 >= len(args) {
+					return usageErrorf("--keep requires a number")
+				}
+				value = args[i+1]
+				i++
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return fmt.Errorf("invalid --keep value '%s': expected a non-negative number", value)
+			}
+			keep = n
+		default:
+			positional = append(positional, arg)
+		}
+	}
+	args = positional
+
+	if cleanAll {
+		result, err := logger.PruneAllLogFiles(keep)
+		if err != nil {
+			return fmt.Errorf("failed to clean logs: %w", err)
+		}
+		c.printPruneResult(result, fmt.Sprintf("keeping the newest %d per project", keep))
+		return nil
+	}
+
+	if clean {
+		if len(args) < 1 {
+			return fmt.Errorf("project name required. Usage: atempo logs <project_name> --clean [--keep N]")
+		}
+		result, err := logger.PruneLogFiles(args[0], keep)
+		if err != nil {
+			return fmt.Errorf("failed to clean logs: %w", err)
+		}
+		c.printPruneResult(result, fmt.Sprintf("keeping the newest %d for %s", keep, args[0]))
+		return nil
+	}
+
 	if len(args) < 1 {
 		fmt.Println("Usage: atempo logs <project_name>")
 		fmt.Println("\nExample: atempo logs my-laravel-app")
 		return fmt.Errorf("project name required")
 	}
 
-	projectName := args[0]
+	return c.showLatestLog(args[0])
+}
 
+// showLatestLog prints the newest setup log for a project and lists older ones
+func (c *LogsCommand) showLatestLog(projectName string) error {
 	// Get the latest log file for the project
 	logFile, err := logger.GetLatestLogFile(projectName)
 	if err != nil {
@@ -169,7 +652,7 @@ func (c *LogsCommand) Execute(ctx context.Context, args []string) error {
 		return fmt.Errorf("failed to read log file: %w", err)
 	}
 
-	fmt.Print(string(content))
+	c.printLog(string(content))
 
 	// Show all available log files if there are multiple
 	allLogs, err := logger.GetAllLogFiles(projectName)
@@ -185,6 +668,64 @@ func (c *LogsCommand) Execute(ctx context.Context, args []string) error {
 	return nil
 }
 
+// printPruneResult reports how many log files were removed and the space freed
+func (c *LogsCommand) printPruneResult(result logger.PruneResult, scope string) {
+	if result.Removed == 0 {
+		fmt.Printf("✅ Nothing to clean (%s)\n", scope)
+		return
+	}
+	fmt.Printf("✅ Removed %d log file(s), freed %s (%s)\n", result.Removed, utils.FormatBytes(uint64(result.Freed)), scope)
+}
+
+// printLog displays log content, colorizing step markers when writing to a terminal
+// and summarizing failed steps at the end
+func (c *LogsCommand) printLog(content string) {
+	if !ui.ColorEnabled() {
+		fmt.Print(content)
+		c.printFailedSteps(content)
+		return
+	}
+
+	for _, raw := range strings.Split(strings.TrimSuffix(content, "\n"), "\n") {
+		line := logger.ParseLine(raw)
+		switch line.Kind {
+		case logger.LineStepStarted:
+			fmt.Printf("%s▶ %s%s\n", ColorBlue, raw, ColorReset)
+		case logger.LineStepCompleted:
+			fmt.Printf("%s✓ %s%s\n", ColorGreen, raw, ColorReset)
+		case logger.LineStepWarning:
+			fmt.Printf("%s⚠ %s%s\n", ColorYellow, raw, ColorReset)
+		case logger.LineStepError:
+			fmt.Printf("%s\033[1m✗ %s%s\n", ColorRed, raw, ColorReset)
+		default:
+			if line.Timestamp == "" {
+				fmt.Println(raw)
+			} else {
+				fmt.Printf("%s%s%s\n", ColorGray, raw, ColorReset)
+			}
+		}
+	}
+	c.printFailedSteps(content)
+}
+
+// printFailedSteps lists steps that ended with an error
+func (c *LogsCommand) printFailedSteps(content string) {
+	var failed []logger.Line
+	for _, raw := range strings.Split(content, "\n") {
+		if line := logger.ParseLine(raw); line.Kind == logger.LineStepError {
+			failed = append(failed, line)
+		}
+	}
+	if len(failed) == 0 {
+		return
+	}
+
+	fmt.Printf("\n%s❌ Failed steps:%s\n", ColorRed, ColorReset)
+	for _, line := range failed {
+		fmt.Printf("  • %s [%s]: %s\n", line.Step, line.Timestamp, line.Detail)
+	}
+}
+
 // DescribeCommand provides detailed project description using context
 type DescribeCommand struct {
 	*BaseCommand
@@ -196,7 +737,7 @@ func NewDescribeCommand(ctx *CommandContext) *DescribeCommand {
 		BaseCommand: NewBaseCommand(
 			"describe",
 			"Show detailed project description and context",
-			"atempo describe [project]",
+			"atempo describe [project] [--stats] [--logs [--runtime]]",
 			ctx,
 		),
 	}
@@ -206,6 +747,27 @@ func NewDescribeCommand(ctx *CommandContext) *DescribeCommand {
 func (c *DescribeCommand) Execute(ctx context.Context, args []string) error {
 	var projectPath string
 	var projectName string
+
+	// --logs follows the description with the setup log; --runtime tails container logs instead.
+	// --stats adds a one-off sample of each container's resource usage.
+	var showLogs, runtimeLogs, showStats bool
+	var positional []string
+	for _, arg := range args {
+		switch arg {
+		case "--stats":
+			showStats = true
+		case "--logs":
+			showLogs = true
+		case "--runtime":
+			runtimeLogs = true
+		default:
+			positional = append(positional, arg)
+		}
+	}
+	if runtimeLogs && !showLogs {
+		return usageErrorf("--runtime is used with --logs: atempo describe [project] --logs --runtime")
+	}
+	args = positional
 	
 	// Parse optional project argument
 	if len(args) >= 1 {
@@ -219,7 +781,7 @@ func (c *DescribeCommand) Execute(ctx context.Context, args []string) error {
 	} else {
 		// Use current directory
 		var err error
-		projectPath, err = os.Getwd()
+		projectPath, err = utils.CurrentProjectDir()
 		if err != nil {
 			return fmt.Errorf("failed to get current directory: %w", err)
 		}
@@ -265,6 +827,9 @@ func (c *DescribeCommand) Execute(ctx context.Context, args []string) error {
 				}
 			}
 		}
+
+		// Unregistered projects may still be running; ask docker directly
+		registry.InspectProject(project)
 	}
 
 	// Update project status if it's in the registry
@@ -276,14 +841,44 @@ func (c *DescribeCommand) Execute(ctx context.Context, args []string) error {
 		}
 	}
 
-	c.displayProjectInfo(project)
+	// Fall back to the ports declared in atempo.json when nothing is running
+	configuredOnly := false
+	if len(project.Ports) == 0 {
+		if ports, urls, err := registry.ConfiguredPorts(project.Path); err == nil && len(ports) > 0 {
+			project.Ports = ports
+			project.URLs = urls
+			configuredOnly = true
+		}
+	}
+
+	var stats []docker.ContainerStats
+	if showStats {
+		stats, err = docker.ProjectStats(project.Path)
+		if err != nil {
+			fmt.Printf("⚠️  Could not read resource usage: %v\n", err)
+			showStats = false
+		}
+	}
+
+	c.displayProjectInfo(project, configuredOnly, showStats, stats)
+
+	if runtimeLogs {
+		fmt.Println()
+		return docker.ExecuteCommand("logs", project.Path, nil)
+	}
+	if showLogs {
+		fmt.Println()
+		return NewLogsCommand(c.ctx).showLatestLog(project.Name)
+	}
 	return nil
 }
 
 // displayProjectInfo displays comprehensive project information
-func (c *DescribeCommand) displayProjectInfo(project *registry.Project) {
-	fmt.Printf("📋 Project Description: %s\n", project.Name)
-	fmt.Println(strings.Repeat("=", 50))
+// When configuredOnly is true, URLs and ports come from atempo.json rather than live containers.
+// With showStats, the resource usage sampled in stats follows the services.
+func (c *DescribeCommand) displayProjectInfo(project *registry.Project, configuredOnly, showStats bool, stats []docker.ContainerStats) {
+	ui.Printf("📋 Project Description: %s\n", project.Name)
+	ui.Println(strings.Repeat("=", 50))
 	
 	// Basic project information
 	fmt.Printf("🏷️  Name: %s\n", project.Name)
@@ -326,7 +921,11 @@ func (c *DescribeCommand) displayProjectInfo(project *registry.Project) {
 
 	// URLs if available
 	if len(project.URLs) > 0 {
-		fmt.Printf("🌐 URLs: %s\n", strings.Join(project.URLs, ", "))
+		if configuredOnly {
+			ui.Printf("🌐 URLs (when running): %s\n", strings.Join(project.URLs, ", "))
+		} else {
+			ui.Printf("🌐 URLs: %s\n", strings.Join(project.URLs, ", "))
+		}
 	}
 
 	fmt.Println()
@@ -338,10 +937,14 @@ func (c *DescribeCommand) displayProjectInfo(project *registry.Project) {
 		for _, service := range project.Services {
 			var serviceIcon string
 			switch service.Status {
-			case "running":
+			case "running", "healthy":
 				serviceIcon = "🟢"
+			case "completed":
+				serviceIcon = "✅"
 			case "stopped":
 				serviceIcon = "🔴"
+			case "crash-looping":
+				serviceIcon = "🔁"
 			default:
 				serviceIcon = "🟡"
 			}
@@ -349,14 +952,42 @@ func (c *DescribeCommand) displayProjectInfo(project *registry.Project) {
 			if service.URL != "" {
 				fmt.Printf(" → %s", service.URL)
 			}
+			if service.Status == "crash-looping" {
+				fmt.Printf(" %s(crash-looping, %d restarts)%s", ColorRed, service.Restarts, ColorReset)
+			} else if service.Restarts > 0 {
+				fmt.Printf(" (%d restarts)", service.Restarts)
+			}
 			fmt.Println()
 		}
 		fmt.Println()
 	}
 
+	// Resource usage (--stats)
+	if showStats {
+		fmt.Println("📊 Resource Usage")
+		fmt.Println(strings.Repeat("-", 30))
+		if len(stats) == 0 {
+			fmt.Println("  No running containers")
+		} else {
+			fmt.Printf("  %-16s %8s  %-22s %7s  %s\n", "SERVICE", "CPU", "MEMORY", "MEM %", "NET I/O")
+			for _, stat := range stats {
+				name := stat.Service
+				if name == "" {
+					name = stat.Container
+				}
+				fmt.Printf("  %-16s %8s  %-22s %7s  %s\n", name, stat.CPU, stat.Memory, stat.MemoryPct, stat.NetIO)
+			}
+		}
+		fmt.Println()
+	}
+
 	// Port mappings
 	if len(project.Ports) > 0 {
-		fmt.Println("🔌 Port Mappings")
+		if configuredOnly {
+			fmt.Println("🔌 Port Mappings (configured in atempo.json, services not running)")
+		} else {
+			fmt.Println("🔌 Port Mappings")
+		}
 		fmt.Println(strings.Repeat("-", 30))
 		for _, port := range project.Ports {
 			fmt.Printf("  %s: localhost:%d → container:%d\n", port.Service, port.External, port.Internal)
@@ -365,16 +996,16 @@ func (c *DescribeCommand) displayProjectInfo(project *registry.Project) {
 	}
 
 	// Quick actions
-	fmt.Println("💡 Quick Actions")
-	fmt.Println(strings.Repeat("-", 30))
+	ui.Println("💡 Quick Actions")
+	ui.Println(strings.Repeat("-", 30))
 	if project.Status == "stopped" || project.Status == "no-docker" {
-		fmt.Printf("  atempo docker up %s      # Start services\n", project.Name)
+		ui.Printf("  atempo docker up %s      # Start services\n", project.Name)
 	} else if project.Status == "running" {
-		fmt.Printf("  atempo docker down %s    # Stop services\n", project.Name)
-		fmt.Printf("  atempo docker logs %s    # View logs\n", project.Name)
+		ui.Printf("  atempo docker down %s    # Stop services\n", project.Name)
+		ui.Printf("  atempo docker logs %s    # View logs\n", project.Name)
 	}
-	fmt.Printf("  atempo logs %s           # View setup logs\n", project.Name)
-	fmt.Printf("  cd %s           # Navigate to project\n", project.Path)
+	ui.Printf("  atempo logs %s           # View setup logs\n", project.Name)
+	ui.Printf("  cd %s           # Navigate to project\n", project.Path)
 }
 
 // RemoveCommand removes a project from the registry
@@ -397,7 +1028,7 @@ func NewRemoveCommand(ctx *CommandContext) *RemoveCommand {
 // Execute runs the remove command
 func (c *RemoveCommand) Execute(ctx context.Context, args []string) error {
 	if len(args) < 1 {
-		return fmt.Errorf("usage: %s\nExample: atempo remove my-app", c.Usage())
+		return usageErrorf("usage: %s\nExample: atempo remove my-app", c.Usage())
 	}
 
 	projectName := args[0]
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"strings"
 	"time"
+
+	"atempo/internal/ui"
 )
 
 // ProgressTracker provides real-time progress updates with animated indicators
@@ -32,7 +34,7 @@ func (p *ProgressTracker) StartStep(stepIndex int, description string) {
 	p.stepStartTime = time.Now()
 	
 	// Start the step with a thinking indicator
-	fmt.Printf("%s✶%s %s %s[%d/%d]%s\n", 
+	ui.Printf("%s✶%s %s %s[%d/%d]%s\n", 
 		ColorBlue, ColorReset, 
 		description,
 		ColorGray, stepIndex, p.totalSteps, ColorReset)
@@ -40,20 +42,20 @@ func (p *ProgressTracker) StartStep(stepIndex int, description string) {
 
 // UpdateStep provides a sub-step update within the current step
 func (p *ProgressTracker) UpdateStep(subDescription string) {
-	fmt.Printf("%s  ⚡%s %s\n", ColorYellow, ColorReset, subDescription)
+	ui.Printf("%s  ⚡%s %s\n", ColorYellow, ColorReset, subDescription)
 }
 
 // CompleteStep marks the current step as complete
 func (p *ProgressTracker) CompleteStep(details string) {
 	elapsed := time.Since(p.stepStartTime)
-	fmt.Printf("%s⏺%s %s\n", ColorGreen, ColorReset, p.currentStep)
+	ui.Printf("%s⏺%s %s\n", ColorGreen, ColorReset, p.currentStep)
 	if details != "" {
-		fmt.Printf("  %s⎿%s  %s %s(%s)%s\n", 
+		ui.Printf("  %s⎿%s  %s %s(%s)%s\n", 
 			ColorGray, ColorReset,
 			details,
 			ColorGray, p.formatDuration(elapsed), ColorReset)
 	}
-	fmt.Println()
+	ui.Println()
 }
 
 // ErrorStep marks the current step as failed
@@ -77,7 +79,7 @@ func (p *ProgressTracker) ShowProgress() {
 	totalElapsed := time.Since(p.startTime)
 	percentage := float64(p.currentIndex) / float64(p.totalSteps) * 100
 	
-	fmt.Printf("%sProgress: %.0f%% (%d/%d) • %s elapsed%s\n", 
+	ui.Printf("%sProgress: %.0f%% (%d/%d) • %s elapsed%s\n", 
 		ColorGray, percentage, p.currentIndex, p.totalSteps, 
 		p.formatDuration(totalElapsed), ColorReset)
 }
@@ -92,15 +94,15 @@ func (p *ProgressTracker) Complete(projectName string) {
 	
 	// Show log file for debugging if needed
 	if logPath := p.getLogPath(projectName); logPath != "" {
-		fmt.Printf("%s📄 Logs: %s%s\n", ColorGray, logPath, ColorReset)
+		ui.Printf("%s📄 Logs: %s%s\n", ColorGray, logPath, ColorReset)
 	}
 	
 	// Show concise next steps
-	fmt.Printf("\n%sNext steps:%s\n", ColorBlue, ColorReset)
-	fmt.Printf("  %s%s code%s         Open in VS Code\n", ColorCyan, projectName, ColorReset)
-	fmt.Printf("  %s%s up%s           Start services\n", ColorCyan, projectName, ColorReset)
-	fmt.Printf("  %s%s status%s       Check status\n", ColorCyan, projectName, ColorReset)
-	fmt.Println()
+	ui.Printf("\n%sNext steps:%s\n", ColorBlue, ColorReset)
+	ui.Printf("  %s%s code%s         Open in VS Code\n", ColorCyan, projectName, ColorReset)
+	ui.Printf("  %s%s up%s           Start services\n", ColorCyan, projectName, ColorReset)
+	ui.Printf("  %s%s status%s       Check status\n", ColorCyan, projectName, ColorReset)
+	ui.Println()
 }
 
 
@@ -3,8 +3,10 @@ package commands
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"atempo/internal/registry"
+	"atempo/internal/ui"
 )
 
 // ProjectsCommand handles listing all registered projects
@@ -18,20 +20,54 @@ func NewProjectsCommand(ctx *CommandContext) *ProjectsCommand {
 		BaseCommand: NewBaseCommand(
 			"projects",
 			"List all registered projects",
-			"atempo projects",
+			"atempo projects [--refresh] [--names]",
 			ctx,
 		),
 	}
 }
 
-// Execute runs the projects command
+// Execute runs the projects command. By default it only reads the registry, which is
+// instant; --refresh queries docker for live status, which is slower.
 func (c *ProjectsCommand) Execute(ctx context.Context, args []string) error {
+	var refresh, namesOnly bool
+	for _, arg := range args {
+		switch arg {
+		case "--refresh":
+			refresh = true
+		case "--names":
+			namesOnly = true
+		default:
+			return usageErrorf("unknown flag: %s. Usage: %s", arg, c.Usage())
+		}
+	}
+
 	reg, err := registry.LoadRegistry()
 	if err != nil {
 		return fmt.Errorf("failed to load registry: %w", err)
 	}
 
+	if refresh {
+		ui.Printf("🔄 Checking live status with docker...")
+		if err := reg.UpdateAllProjectsStatus(); err != nil {
+			fmt.Printf(" failed: %v\n", err)
+		} else {
+			ui.Println(" done")
+		}
+	}
+
 	projects := reg.ListProjects()
+
+	// --names prints bare project names and aliases, one per line (used by shell completion)
+	if namesOnly {
+		for _, project := range projects {
+			fmt.Println(project.Name)
+			for _, alias := range project.Aliases {
+				fmt.Println(alias)
+			}
+		}
+		return nil
+	}
+
 	if len(projects) == 0 {
 		fmt.Println("No projects registered yet.")
 		fmt.Println("Projects are automatically registered when you run 'atempo create'")
@@ -45,9 +81,22 @@ func (c *ProjectsCommand) Execute(ctx context.Context, args []string) error {
 		fmt.Printf("  %s\n", project.Name)
 		fmt.Printf("    Framework: %s %s\n", project.Framework, project.Version)
 		fmt.Printf("    Path: %s\n", project.Path)
+		if len(project.Aliases) > 0 {
+			fmt.Printf("    Aliases: %s\n", strings.Join(project.Aliases, ", "))
+		}
 		fmt.Printf("    Created: %s\n", project.CreatedAt.Format("2006-01-02 15:04"))
+		if refresh {
+			fmt.Printf("    Status: %s\n", project.Status)
+			for _, url := range project.URLs {
+				fmt.Printf("    URL: %s\n", url)
+			}
+		}
 		fmt.Println()
 	}
 
+	if !refresh {
+		ui.Println("💡 Registry data only; run 'atempo projects --refresh' for live docker status")
+	}
+
 	return nil
 }
\ No newline at end of file
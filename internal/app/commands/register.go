@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"atempo/internal/compose"
+	"atempo/internal/registry"
+	"atempo/internal/scaffold"
+	"atempo/internal/ui"
+)
+
+// RegisterCommand adds an existing project directory to the registry, e.g. one
+// created with --no-register that turned out to be worth keeping
+type RegisterCommand struct {
+	*BaseCommand
+}
+
+// NewRegisterCommand creates a new register command
+func NewRegisterCommand(ctx *CommandContext) *RegisterCommand {
+	return &RegisterCommand{
+		BaseCommand: NewBaseCommand(
+			"register",
+			"Add an existing project directory to the registry",
+			"atempo register [path] [--name <name>]",
+			ctx,
+		),
+	}
+}
+
+// Execute registers the project at path (default: the current directory)
+func (c *RegisterCommand) Execute(ctx context.Context, args []string) error {
+	var name string
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--name":
+			if i+1 >= len(args) {
+				return usageErrorf("--name requires a value")
+			}
+			name = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--name="):
+			name = strings.TrimPrefix(arg, "--name=")
+		case strings.HasPrefix(arg, "-"):
+			return usageErrorf("unknown flag: %s. Usage: %s", arg, c.Usage())
+		default:
+			positional = append(positional, arg)
+		}
+	}
+	if len(positional) > 1 {
+		return usageErrorf("unexpected argument '%s'. Usage: %s", positional[1], c.Usage())
+	}
+
+	projectPath := "."
+	if len(positional) == 1 {
+		projectPath = positional[0]
+	}
+	projectPath, err := filepath.Abs(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve project path: %w", err)
+	}
+	if info, err := os.Stat(projectPath); err != nil || !info.IsDir() {
+		return fmt.Errorf("project directory not found: %s", projectPath)
+	}
+
+	config, err := compose.LoadAtempoConfig(projectPath)
+	if err != nil {
+		if errors.Is(err, compose.ErrConfigNotFound) {
+			return fmt.Errorf("%s is not an atempo project (no atempo.json); scaffold the Atempo files into it with 'atempo create <framework> --no-install' first", projectPath)
+		}
+		return err
+	}
+
+	// Only register projects that 'atempo reconfigure' can generate compose files for
+	warnings, err := compose.ValidateConfig(projectPath)
+	if err != nil {
+		return fmt.Errorf("atempo.json is invalid: %w (see 'atempo validate')", err)
+	}
+	for _, warning := range warnings {
+		fmt.Printf("⚠️  %s\n", warning)
+	}
+
+	// Prefer the name in atempo.json, as 'atempo create' does, unless it is still a template
+	if name == "" {
+		name = config.Name
+		if name == "" || strings.Contains(name, "{{") {
+			name = filepath.Base(projectPath)
+		}
+	}
+	if err := scaffold.ValidateProjectName(name); err != nil {
+		return usageErrorf("%v; pass --name to choose another", err)
+	}
+
+	reg, err := registry.LoadRegistry()
+	if err != nil {
+		return fmt.Errorf("failed to load registry: %w", err)
+	}
+
+	for _, project := range reg.ListProjects() {
+		if project.Path == projectPath {
+			fmt.Printf("✅ %s is already registered as '%s'\n", projectPath, project.Name)
+			return nil
+		}
+		if project.Name == name {
+			return fmt.Errorf("project name '%s' is already used by %s; pass --name to choose another", name, project.Path)
+		}
+	}
+
+	if err := reg.AddProject(name, projectPath, config.Framework, config.Version); err != nil {
+		return fmt.Errorf("failed to register project: %w", err)
+	}
+
+	ui.Printf("✅ Registered '%s' (%s)\n", name, projectPath)
+	ui.Printf("💡 Try 'atempo status %s' or '%s up'\n", name, name)
+	return nil
+}
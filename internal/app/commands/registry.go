@@ -21,6 +21,7 @@ type CommandRegistry struct {
 // NewCommandRegistry creates a new command registry
 func NewCommandRegistry(templatesFS, mcpServersFS embed.FS) *CommandRegistry {
 	ctx := &CommandContext{}
+	ApplyGlobalOptions(GlobalOptions{})
 	
 	registry := &CommandRegistry{
 		commands: make(map[string]Command),
@@ -29,15 +30,34 @@ func NewCommandRegistry(templatesFS, mcpServersFS embed.FS) *CommandRegistry {
 	
 	// Register all commands
 	registry.register(NewCreateCommand(ctx, templatesFS, mcpServersFS))
+	registry.register(NewAICommand(ctx, templatesFS))
+	registry.register(NewMCPCommand(ctx))
+	registry.register(NewAliasCommand(ctx))
 	registry.register(NewAuthCommand(ctx))
 	registry.register(NewDockerCommand(ctx))
 	registry.register(NewProjectsCommand(ctx))
 	registry.register(NewStatusCommand(ctx))
+	registry.register(NewStartAllCommand(ctx))
+	registry.commands["up-all"] = registry.commands["start-all"]
+	registry.register(NewStopAllCommand(ctx))
+	registry.register(NewWatchCommand(ctx))
 	registry.register(NewReconfigureCommand(ctx))
+	registry.register(NewValidateCommand(ctx))
+	registry.register(NewServicesCommand(ctx))
+	registry.register(NewUpgradeCheckCommand(ctx, templatesFS))
 	registry.register(NewAddServiceCommand(ctx))
+	registry.register(NewListServicesCommand(ctx))
 	registry.register(NewLogsCommand(ctx))
 	registry.register(NewDescribeCommand(ctx))
 	registry.register(NewRemoveCommand(ctx))
+	registry.register(NewRegisterCommand(ctx))
+	registry.register(NewMigrateCommand(ctx))
+	registry.register(NewSeedCommand(ctx))
+	registry.register(NewArtisanCommand(ctx))
+	registry.register(NewManageCommand(ctx))
+	registry.register(NewDoctorCommand(ctx, templatesFS))
+	registry.register(NewConfigCommand(ctx, templatesFS))
+	registry.register(NewCompletionCommand(ctx, registry))
 	registry.register(NewShellCommand(ctx, registry))
 	
 	return registry
@@ -50,6 +70,22 @@ func (r *CommandRegistry) register(cmd Command) {
 
 // Execute runs a command by name or routes project commands
 func (r *CommandRegistry) Execute(ctx context.Context, commandName string, args []string) error {
+	// Global flags (e.g. --quiet) may precede the command name
+	if strings.HasPrefix(commandName, "-") && !IsHelpCommand(commandName) {
+		opts, remaining, err := ParseGlobalFlags(append([]string{commandName}, args...))
+		if err != nil {
+			return err
+		}
+		if err := ApplyGlobalOptions(opts); err != nil {
+			return err
+		}
+		if len(remaining) == 0 {
+			r.ShowUsage()
+			return nil
+		}
+		commandName, args = remaining[0], remaining[1:]
+	}
+
 	// First check if it's a registered global command
 	if cmd, exists := r.commands[commandName]; exists {
 		return cmd.Execute(ctx, args)
@@ -58,7 +94,7 @@ func (r *CommandRegistry) Execute(ctx context.Context, commandName string, args
 	// Check if commandName is a project name
 	if r.IsProjectName(commandName) {
 		if len(args) == 0 {
-			return fmt.Errorf("project command required. Usage: %s <command>", commandName)
+			return usageErrorf("project command required. Usage: %s <command>", commandName)
 		}
 		
 		// Route to project command handler
@@ -67,7 +103,7 @@ func (r *CommandRegistry) Execute(ctx context.Context, commandName string, args
 		return r.executeProjectCommand(ctx, commandName, projectCommand, projectArgs)
 	}
 	
-	return fmt.Errorf("unknown command: %s", commandName)
+	return usageErrorf("unknown command: %s", commandName)
 }
 
 // GetCommand returns a command by name
@@ -96,14 +132,19 @@ func (r *CommandRegistry) ShowUsage() {
     ╚═╝  ╚═╝   ╚═╝   ╚══════╝╚═╝     ╚═╝╚═╝      ╚═════╝ 
 
 Usage:
-  atempo <command> [arguments]
+  atempo [global options] <command> [arguments]
+
+Global Options:
+  -q, --quiet          Suppress decorative output and colors (also honors NO_COLOR)
+  -C, --cwd <dir>      Run as if atempo was started in <dir>
 
 Commands:`)
 
 	// Display commands in a logical order
 	commandOrder := []string{
-		"create", "auth", "status", "describe", "docker", 
-		"reconfigure", "add-service", "projects", "remove", "logs",
+		"create", "auth", "status", "watch", "describe", "docker", "start-all", "stop-all",
+		"reconfigure", "validate", "services", "upgrade-check", "add-service", "list-services", "migrate", "seed", "artisan", "manage", "ai", "mcp", "projects", "alias", "register", "remove", "logs",
+		"doctor", "config", "completion",
 	}
 	
 	for _, cmdName := range commandOrder {
@@ -118,20 +159,82 @@ Examples:
   atempo create laravel:11 my-app       Create Laravel 11 in ./my-app/
   atempo create django                  Create Django (latest) in current directory
   atempo create django:5                Create Django 5 in current directory
+  atempo create laravel api --no-install
+                                        Scaffold Atempo files only; add the app to src/ later
+  atempo create laravel my-app --skip docker
+                                        Scaffold without starting containers (CI); steps:
+                                        install, templates, post-install, docker, register
+  atempo config set defaults.laravel.version 11
+                                        Use Laravel 11 when 'create laravel' gives no version
+  atempo create laravel scratch --no-register
+                                        Throwaway project; keep it later with 'atempo register'
+  atempo create laravel my-app --set team=payments
+                                        Substitute {{team}} in template files
   atempo status                         Show dashboard with all project statuses
+  atempo status my-app --wait-healthy   Block until all services are healthy (exit 1 on timeout)
+  atempo watch my-app --webhook http://localhost:9000/hook
+                                        POST a JSON event when the project's status changes
   atempo describe my-app                Show detailed description of 'my-app' project
   atempo describe                       Describe project in current directory
+  atempo describe my-app --logs         Describe, then show the latest setup log (--runtime: container logs)
+  atempo describe my-app --stats        Include CPU, memory and network usage per service
   atempo docker up                      Start services in current directory
   atempo docker up my-app               Start services for registered project 'my-app'
+  atempo stop-all --concurrency 8       Stop every running project, 8 at a time
+  atempo start-all --fail-fast          Stop starting projects after the first failure (exit 1)
+  atempo up-all --tag backend           Start projects whose atempo.json has "tags": ["backend"]
   atempo reconfigure                    Regenerate docker-compose.yml from atempo.json
+  atempo reconfigure --compose-version none
+                                        Omit 'version:' for the Compose Specification
+  atempo reconfigure --check            Fail with a diff if docker-compose.yml is stale (CI)
+  atempo reconfigure --merge            Keep services added to docker-compose.yml by hand
+  atempo reconfigure --capture          Move env vars and volumes added by hand into atempo.json
+  atempo reconfigure --validate         Refuse to generate when atempo.json has errors
+  atempo reconfigure --prune-orphans    Also remove containers of services deleted from atempo.json
+  atempo validate                       Check atempo.json (e.g. privileged ports, undeclared networks)
+  atempo validate --strict              Also fail on unknown keys in atempo.json (typos)
+  atempo services my-app                Show services from atempo.json (works offline)
+  atempo upgrade-check my-app           Compare the framework version with the latest supported major
+  atempo ai refresh                     Re-copy AI context templates (compose untouched)
+  atempo ai status my-app               Show whether the AI context is older than the code
+  atempo mcp test my-app                Check the project's MCP server handshake and tools
+  atempo migrate my-app --fresh --seed  Run migrations in the app container
+  atempo create laravel:11 my-app --seed
+                                        Seed the database after the first migration
+  atempo seed my-app                    Run the framework seeder (Django: fixture names)
+  atempo artisan route:list             Run php artisan in the Laravel app container
+  atempo manage createsuperuser         Run python manage.py in the Django web container
+  atempo list-services                  Show the catalog of services add-service can add
   atempo add-service minio              Add MinIO object storage service
-  atempo projects                       List all registered projects
+  atempo add-service --build --name worker --dockerfile infra/docker/worker.Dockerfile
+                                        Add a custom Dockerfile-based service
+  atempo projects                       List all registered projects (registry only, instant)
+  atempo projects --refresh             Also check live status with docker (slower)
+  atempo alias add my-laravel-app mla   Let 'mla' stand in for 'my-laravel-app'
   atempo logs my-app                    View setup logs for 'my-app' project
+  atempo logs --clean-all --keep 3      Prune old setup logs across all projects
+  atempo doctor --json                  Check environment readiness as JSON (for CI)
+  atempo doctor templates               Check where each framework template resolves from
+  source <(atempo completion bash)      Enable bash completion for this session
 
 Project Management:
   - Projects are automatically registered when created with 'atempo create'
+  - 'projects' only reads the registry; 'status', 'describe' and 'projects --refresh'
+    query docker for live container status
   - Use project names instead of paths: 'atempo docker up my-laravel-app'
   - Services defined in atempo.json generate docker-compose.yml automatically
+  - Networks a service joins (list or {"name": {"aliases": [...]}} form) must be
+    declared under "networks" in atempo.json; generation fails otherwise
+
+Exit Codes:
+  0  Success
+  1  General failure (also: reconfigure --check drift, status --wait-healthy timeout)
+  2  Usage error (unknown command or flag, missing argument)
+  3  Docker not available (not installed, or the daemon is not running)
+  4  Project not found (unknown name, or no atempo.json)
+  5  Scaffolding failed during 'atempo create'
+  6  'atempo doctor' found a failing check (warnings alone exit with 1)
+  130 Interrupted (Ctrl+C) while a docker command was running
 
 For more information about specific commands:
   atempo <command> --help`)
@@ -204,9 +307,14 @@ func (r *CommandRegistry) executeProjectCommand(ctx context.Context, projectName
 		return dockerCmd.Execute(ctx, append([]string{"down", projectName}, args...))
 	
 	case "status":
-		// Execute status for this project
+		// Status only takes a project with --wait-healthy; otherwise show the dashboard
 		statusCmd := r.commands["status"]
-		return statusCmd.Execute(ctx, append([]string{projectName}, args...))
+		for _, arg := range args {
+			if arg == "--wait-healthy" {
+				return statusCmd.Execute(ctx, append([]string{projectName}, args...))
+			}
+		}
+		return statusCmd.Execute(ctx, args)
 	
 	case "logs":
 		// Execute logs for this project
@@ -223,6 +331,41 @@ func (r *CommandRegistry) executeProjectCommand(ctx context.Context, projectName
 		dockerCmd := r.commands["docker"]
 		return dockerCmd.Execute(ctx, append([]string{"bash", projectName}, args...))
 	
+	case "validate":
+		// Validate atempo.json for this project
+		validateCmd := r.commands["validate"]
+		return validateCmd.Execute(ctx, append([]string{projectName}, args...))
+	
+	case "services":
+		// Show services declared in atempo.json for this project
+		servicesCmd := r.commands["services"]
+		return servicesCmd.Execute(ctx, append([]string{projectName}, args...))
+	
+	case "watch":
+		// Watch health transitions for this project
+		watchCmd := r.commands["watch"]
+		return watchCmd.Execute(ctx, append([]string{projectName}, args...))
+	
+	case "upgrade-check":
+		// Advise on framework upgrades for this project
+		upgradeCheckCmd := r.commands["upgrade-check"]
+		return upgradeCheckCmd.Execute(ctx, append([]string{projectName}, args...))
+	
+	case "migrate":
+		// Execute migrations for this project
+		migrateCmd := r.commands["migrate"]
+		return migrateCmd.Execute(ctx, append([]string{projectName}, args...))
+	
+	case "seed":
+		// Seed the database for this project
+		seedCmd := r.commands["seed"]
+		return seedCmd.Execute(ctx, append([]string{projectName}, args...))
+	
+	case "artisan", "manage":
+		// Proxy framework CLI commands for this project
+		proxyCmd := r.commands[command]
+		return proxyCmd.Execute(ctx, append([]string{"--project", projectName}, args...))
+	
 	case "reconfigure", "reconfig":
 		// Execute reconfigure for this project
 		reconfigCmd := r.commands["reconfigure"]
@@ -245,7 +388,7 @@ func (r *CommandRegistry) executeProjectCommand(ctx context.Context, projectName
 		return r.openProjectInBrowser(projectName, args)
 	
 	default:
-		return fmt.Errorf("unknown project command: %s. Available: up, down, status, logs, describe, shell, reconfigure, code, cd, delete, open", command)
+		return usageErrorf("unknown project command: %s. Available: up, down, status, logs, describe, shell, validate, services, upgrade-check, watch, migrate, seed, artisan, manage, reconfigure, code, cd, delete, open", command)
 	}
 }
 
@@ -397,33 +540,45 @@ func moveToTrash(path string) error {
 
 // openProjectInBrowser opens the project or specific service in the default browser
 func (r *CommandRegistry) openProjectInBrowser(projectName string, args []string) error {
+	targetURL, err := resolveProjectURL(projectName, args)
+	if err != nil {
+		return err
+	}
+
+	// Open URL in default browser
+	return openURL(targetURL)
+}
+
+// resolveProjectURL refreshes a project's status and returns its main URL, or the URL
+// of the service named in args
+func resolveProjectURL(projectName string, args []string) (string, error) {
 	// Load registry to get project details
 	reg, err := registry.LoadRegistry()
 	if err != nil {
-		return fmt.Errorf("failed to load project registry: %w", err)
+		return "", fmt.Errorf("failed to load project registry: %w", err)
 	}
 	
 	// Find the project
 	project, err := reg.FindProject(projectName)
 	if err != nil {
-		return fmt.Errorf("project not found: %s", projectName)
+		return "", fmt.Errorf("project not found: %s", projectName)
 	}
 	
 	// Update project status to get current URLs and services
 	err = reg.UpdateProjectStatus(projectName)
 	if err != nil {
-		return fmt.Errorf("failed to update project status: %w", err)
+		return "", fmt.Errorf("failed to update project status: %w", err)
 	}
 	
 	// Reload to get updated project info
 	project, err = reg.FindProject(projectName)
 	if err != nil {
-		return fmt.Errorf("failed to reload project: %w", err)
+		return "", fmt.Errorf("failed to reload project: %w", err)
 	}
 	
 	// Check if project has running services
 	if project.Status == "stopped" || project.Status == "no-docker" || project.Status == "no-services" {
-		return fmt.Errorf("project '%s' is not running. Start it with: %s up", projectName, projectName)
+		return "", fmt.Errorf("project '%s' is not running. Start it with: %s up", projectName, projectName)
 	}
 	
 	var targetURL string
@@ -431,7 +586,7 @@ func (r *CommandRegistry) openProjectInBrowser(projectName string, args []string
 	if len(args) == 0 {
 		// Open main application (first available URL)
 		if len(project.URLs) == 0 {
-			return fmt.Errorf("no web URLs found for project '%s'. Make sure services are running and have exposed web ports", projectName)
+			return "", fmt.Errorf("no web URLs found for project '%s'. Make sure services are running and have exposed web ports", projectName)
 		}
 		targetURL = project.URLs[0]
 		ShowInfo(fmt.Sprintf("Opening main application: %s", targetURL))
@@ -443,7 +598,7 @@ func (r *CommandRegistry) openProjectInBrowser(projectName string, args []string
 		for _, service := range project.Services {
 			if service.Name == serviceName {
 				if service.URL == "" {
-					return fmt.Errorf("service '%s' doesn't have a web URL (no exposed web ports)", serviceName)
+					return "", fmt.Errorf("service '%s' doesn't have a web URL (no exposed web ports)", serviceName)
 				}
 				targetURL = service.URL
 				found = true
@@ -461,17 +616,16 @@ func (r *CommandRegistry) openProjectInBrowser(projectName string, args []string
 			}
 			
 			if len(availableServices) == 0 {
-				return fmt.Errorf("no services with web URLs found for project '%s'", projectName)
+				return "", fmt.Errorf("no services with web URLs found for project '%s'", projectName)
 			}
 			
-			return fmt.Errorf("service '%s' not found. Available services: %s", serviceName, strings.Join(availableServices, ", "))
+			return "", fmt.Errorf("service '%s' not found. Available services: %s", serviceName, strings.Join(availableServices, ", "))
 		}
 		
 		ShowInfo(fmt.Sprintf("Opening service '%s': %s", serviceName, targetURL))
 	}
 	
-	// Open URL in default browser
-	return openURL(targetURL)
+	return targetURL, nil
 }
 
 // openURL opens a URL in the default browser (cross-platform)
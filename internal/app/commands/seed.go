@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"atempo/internal/docker"
+	"atempo/internal/registry"
+)
+
+// SeedCommand runs the framework's database seeder inside the app container
+type SeedCommand struct {
+	*BaseCommand
+}
+
+// NewSeedCommand creates a new seed command
+func NewSeedCommand(ctx *CommandContext) *SeedCommand {
+	return &SeedCommand{
+		BaseCommand: NewBaseCommand(
+			"seed",
+			"Seed a project's database",
+			"atempo seed [project] [--class <Seeder>] [fixture...]",
+			ctx,
+		),
+	}
+}
+
+// Execute runs db:seed for Laravel, or loaddata with the given fixtures for Django
+func (c *SeedCommand) Execute(ctx context.Context, args []string) error {
+	var class string
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--class":
+			if i+1 >= len(args) {
+				return usageErrorf("--class requires a seeder class name")
+			}
+			class = args[i+1]
+			i++
+		case strings.HasPrefix(arg, "--class="):
+			class = strings.TrimPrefix(arg, "--class=")
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	// A leading project name or path is optional; anything else is a fixture
+	var projectArgs, fixtures []string
+	if len(positional) > 0 && isProjectArg(positional[0]) {
+		projectArgs, fixtures = positional[:1], positional[1:]
+	} else {
+		fixtures = positional
+	}
+
+	projectPath, err := resolveProjectArg(projectArgs)
+	if err != nil {
+		return err
+	}
+
+	framework, err := detectProjectFramework(projectPath)
+	if err != nil {
+		return err
+	}
+
+	var command []string
+	switch framework {
+	case "laravel":
+		if len(fixtures) > 0 {
+			return fmt.Errorf("fixtures are only used for Django projects; use --class to pick a Laravel seeder")
+		}
+		command = []string{"php", "artisan", "db:seed", "--force"}
+		if class != "" {
+			command = append(command, "--class="+class)
+		}
+	case "django":
+		if class != "" {
+			return fmt.Errorf("--class is only supported for Laravel projects")
+		}
+		if len(fixtures) == 0 {
+			return fmt.Errorf("Django seeding loads fixtures; usage: atempo seed [project] <fixture...>")
+		}
+		command = append([]string{"python", "manage.py", "loaddata"}, fixtures...)
+	default:
+		return fmt.Errorf("seeding is not supported for framework '%s'", framework)
+	}
+
+	return docker.ExecuteExecCommand(docker.GetAppService(framework), projectPath, docker.ExecOptions{}, command)
+}
+
+// isProjectArg reports whether an argument names a registered project or a project directory
+func isProjectArg(arg string) bool {
+	projectPath, err := registry.ResolveProjectPath(arg)
+	if err != nil {
+		return false
+	}
+	info, err := os.Stat(projectPath)
+	return err == nil && info.IsDir()
+}
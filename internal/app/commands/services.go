@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"atempo/internal/compose"
+	"atempo/internal/ui"
+)
+
+// ServicesCommand lists the services declared in a project's atempo.json
+type ServicesCommand struct {
+	*BaseCommand
+}
+
+// NewServicesCommand creates a new services command
+func NewServicesCommand(ctx *CommandContext) *ServicesCommand {
+	return &ServicesCommand{
+		BaseCommand: NewBaseCommand(
+			"services",
+			"Show services defined in atempo.json (no docker required)",
+			"atempo services [project]",
+			ctx,
+		),
+	}
+}
+
+// Execute prints each service's type, source, ports and dependencies
+func (c *ServicesCommand) Execute(ctx context.Context, args []string) error {
+	projectPath, err := resolveProjectArg(args)
+	if err != nil {
+		return err
+	}
+
+	config, err := compose.LoadAtempoConfig(projectPath)
+	if err != nil {
+		return err
+	}
+
+	if len(config.Services) == 0 {
+		fmt.Println("No services defined in atempo.json")
+		return nil
+	}
+
+	names := make([]string, 0, len(config.Services))
+	for name := range config.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ui.Printf("Services for %s (%s):\n\n", config.Name, projectPath)
+
+	for _, name := range names {
+		service := config.Services[name]
+
+		fmt.Printf("%s%s%s\n", ColorCyan, name, ColorReset)
+		serviceType := valueOrDash(service.Type)
+		if service.Oneshot {
+			serviceType += " (oneshot)"
+		}
+		fmt.Printf("  Type:       %s\n", serviceType)
+		if service.Type == "build" {
+			buildContext := service.Context
+			if buildContext == "" {
+				buildContext = "."
+			}
+			dockerfile := service.Dockerfile
+			if dockerfile == "" {
+				dockerfile = "Dockerfile"
+			}
+			fmt.Printf("  Build:      %s (context %s)\n", dockerfile, buildContext)
+		} else {
+			fmt.Printf("  Image:      %s\n", valueOrDash(service.Image))
+		}
+		fmt.Printf("  Ports:      %s\n", valueOrDash(strings.Join(service.Ports, ", ")))
+		fmt.Printf("  Depends on: %s\n", valueOrDash(strings.Join(service.DependsOn, ", ")))
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// valueOrDash returns value, or "-" when it is empty
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/chzyer/readline"
+
+	"atempo/internal/ui"
 )
 
 // ShellCommand provides an interactive shell interface
@@ -171,7 +173,9 @@ func (c *ShellCommand) handleBuiltinCommand(input string) bool {
 		return true
 	case "clear", "cls":
 		ShowWorking("Clearing screen...")
-		fmt.Print("\033[2J\033[H") // ANSI clear screen
+		if ui.ColorEnabled() {
+			fmt.Print("\033[2J\033[H") // ANSI clear screen
+		}
 		c.showWelcome()
 		ShowSuccess("Screen cleared", "Welcome screen refreshed")
 		return true
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"atempo/internal/registry"
+	"atempo/internal/ui"
 )
 
 // StatusCommand displays project status dashboard
@@ -19,14 +21,54 @@ func NewStatusCommand(ctx *CommandContext) *StatusCommand {
 		BaseCommand: NewBaseCommand(
 			"status",
 			"Show project dashboard with health status",
-			"atempo status",
+			"atempo status [project --wait-healthy [--timeout <duration>]]",
 			ctx,
 		),
 	}
 }
 
+// defaultWaitTimeout bounds 'status --wait-healthy' when no --timeout is given
+const defaultWaitTimeout = 2 * time.Minute
+
 // Execute runs the status command
 func (c *StatusCommand) Execute(ctx context.Context, args []string) error {
+	waitHealthy := false
+	timeout := defaultWaitTimeout
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--wait-healthy":
+			waitHealthy = true
+		case arg == "--timeout" || strings.HasPrefix(arg, "--timeout="):
+			value := strings.TrimPrefix(arg, "--timeout=")
+			if arg == "--timeout" {
+				if i+1 >= len(args) {
+					return usageErrorf("--timeout requires a value (e.g. 90s or 5m)")
+				}
+				value = args[i+1]
+				i++
+			}
+			duration, err := parseTimeoutValue(value)
+			if err != nil {
+				return err
+			}
+			timeout = duration
+		case strings.HasPrefix(arg, "-"):
+			return usageErrorf("unknown flag: %s. Usage: %s", arg, c.Usage())
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	if waitHealthy {
+		return c.waitHealthy(ctx, positional, timeout)
+	}
+	if len(positional) > 0 {
+		return usageErrorf("unexpected argument '%s': a project is only accepted with --wait-healthy. Usage: %s", positional[0], c.Usage())
+	}
+
 	reg, err := registry.LoadRegistry()
 	if err != nil {
 		return fmt.Errorf("failed to load registry: %w", err)
@@ -39,12 +81,13 @@ func (c *StatusCommand) Execute(ctx context.Context, args []string) error {
 		return nil
 	}
 
-	// Update all project statuses
-	fmt.Print("🔄 Checking project status...")
+	// Update all project statuses (the dashboard shares results across quick re-runs)
+	registry.EnableDiskHealthCache()
+	ui.Printf("🔄 Checking project status...")
 	if err := reg.UpdateAllProjectsStatus(); err != nil {
 		fmt.Printf(" failed: %v\n", err)
 	} else {
-		fmt.Println(" done")
+		ui.Println(" done")
 	}
 
 	// Reload registry to get updated statuses
@@ -55,8 +98,8 @@ func (c *StatusCommand) Execute(ctx context.Context, args []string) error {
 
 	projects = reg.ListProjects()
 	
-	fmt.Println("\n🚀 Atempo Project Dashboard")
-	fmt.Println(strings.Repeat("=", 50))
+	ui.Println("\n🚀 Atempo Project Dashboard")
+	ui.Println(strings.Repeat("=", 50))
 
 	runningCount := 0
 	stoppedCount := 0
@@ -104,16 +147,26 @@ func (c *StatusCommand) Execute(ctx context.Context, args []string) error {
 			for i, service := range project.Services {
 				var serviceIcon string
 				switch service.Status {
-				case "running":
+				case "running", "healthy":
 					serviceIcon = "🟢"
+				case "completed":
+					serviceIcon = "✅"
 				case "stopped":
 					serviceIcon = "🔴"
+				case "crash-looping":
+					serviceIcon = "🔁"
 				default:
 					serviceIcon = "🟡"
 				}
 				serviceStrs[i] = fmt.Sprintf("%s %s", serviceIcon, service.Name)
 			}
 			fmt.Println(strings.Join(serviceStrs, ", "))
+
+			for _, service := range project.Services {
+				if service.Status == "crash-looping" {
+					fmt.Printf("   %s⚠️  %s is crash-looping (%d restarts); see 'atempo docker logs %s %s'%s\n", ColorRed, service.Name, service.Restarts, project.Name, service.Name, ColorReset)
+				}
+			}
 		}
 
 		if len(project.Ports) > 0 {
@@ -139,11 +192,58 @@ func (c *StatusCommand) Execute(ctx context.Context, args []string) error {
 		fmt.Printf("❌ Errors: %d\n", errorCount)
 	}
 
-	fmt.Println("\n💡 Quick Actions:")
-	fmt.Println("  atempo docker up [project]     # Start project services")
-	fmt.Println("  atempo docker down [project]   # Stop project services")
-	fmt.Println("  atempo docker logs [project]   # View service logs")
-	fmt.Println("  atempo logs [project]          # View setup logs")
+	ui.Println("\n💡 Quick Actions:")
+	ui.Println("  atempo docker up [project]     # Start project services")
+	ui.Println("  atempo docker down [project]   # Stop project services")
+	ui.Println("  atempo docker logs [project]   # View service logs")
+	ui.Println("  atempo logs [project]          # View setup logs")
 
 	return nil
-}
\ No newline at end of file
+}
+// waitHealthy polls a project's containers until every service is running or healthy.
+// It returns an ExitError with code 1 when the timeout elapses first.
+func (c *StatusCommand) waitHealthy(ctx context.Context, args []string, timeout time.Duration) error {
+	projectPath, err := resolveProjectArg(args)
+	if err != nil {
+		return err
+	}
+
+	ui.Printf("⏳ Waiting up to %s for services in %s to become healthy...\n", timeout, projectPath)
+
+	deadline := time.Now().Add(timeout)
+	for {
+		status, services := registry.CheckProjectHealth(projectPath)
+		pending := pendingServices(services)
+
+		if status == "running" && len(pending) == 0 {
+			fmt.Printf("✅ All %d service(s) are healthy\n", len(services))
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			detail := status
+			if len(pending) > 0 {
+				detail = strings.Join(pending, ", ")
+			}
+			return &ExitError{Code: 1, Err: fmt.Errorf("timed out after %s waiting for services to become healthy (%s)", timeout, detail)}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// pendingServices returns "name (status)" for services that are not yet running or
+// healthy. One-shot services that completed successfully are not pending.
+func pendingServices(services []registry.Service) []string {
+	var pending []string
+	for _, service := range services {
+		if service.Status != "running" && service.Status != "healthy" && service.Status != "completed" {
+			pending = append(pending, fmt.Sprintf("%s (%s)", service.Name, service.Status))
+		}
+	}
+	return pending
+}
@@ -3,10 +3,12 @@ package commands
 import (
 	"fmt"
 	"time"
+
+	"atempo/internal/ui"
 )
 
-// ANSI color codes
-const (
+// ANSI color codes (emptied by applyColorSettings when colors are disabled)
+var (
 	ColorReset  = "\033[0m"
 	ColorRed    = "\033[31m"
 	ColorGreen  = "\033[32m"
@@ -18,6 +20,19 @@ const (
 	ColorGray   = "\033[90m"
 )
 
+// applyColorSettings resets the color codes according to the current ui settings
+func applyColorSettings() {
+	ColorReset = ui.Color("\033[0m")
+	ColorRed = ui.Color("\033[31m")
+	ColorGreen = ui.Color("\033[32m")
+	ColorYellow = ui.Color("\033[33m")
+	ColorBlue = ui.Color("\033[34m")
+	ColorPurple = ui.Color("\033[35m")
+	ColorCyan = ui.Color("\033[36m")
+	ColorWhite = ui.Color("\033[37m")
+	ColorGray = ui.Color("\033[90m")
+}
+
 // Status types
 type StatusType int
 
@@ -49,7 +64,7 @@ func (s *StatusIndicator) Start(status StatusType, message string) {
 	s.startTime = time.Now()
 	
 	symbol, color := s.getStatusSymbolAndColor(status)
-	fmt.Printf("%s%s%s %s\n", color, symbol, ColorReset, message)
+	ui.Printf("%s%s%s %s\n", color, symbol, ColorReset, message)
 }
 
 // Update shows progress with elapsed time
@@ -57,7 +72,7 @@ func (s *StatusIndicator) Update(status StatusType, message string) {
 	elapsed := time.Since(s.startTime)
 	symbol, color := s.getStatusSymbolAndColor(status)
 	
-	fmt.Printf("%s%s%s %s %s(%s)%s\n", 
+	ui.Printf("%s%s%s %s %s(%s)%s\n", 
 		color, symbol, ColorReset, 
 		message,
 		ColorGray, s.formatDuration(elapsed), ColorReset)
@@ -68,7 +83,7 @@ func (s *StatusIndicator) Success(message, details string) {
 	elapsed := time.Since(s.startTime)
 	fmt.Printf("%s⏺%s %s\n", ColorGreen, ColorReset, message)
 	if details != "" {
-		fmt.Printf("  %s⎿%s  %s %s(%s)%s\n", 
+		ui.Printf("  %s⎿%s  %s %s(%s)%s\n", 
 			ColorGray, ColorReset, 
 			details,
 			ColorGray, s.formatDuration(elapsed), ColorReset)
@@ -89,7 +104,7 @@ func (s *StatusIndicator) Error(message, details string) {
 
 // Info shows an info message
 func (s *StatusIndicator) Info(message string) {
-	fmt.Printf("%s⏺%s %s\n", ColorBlue, ColorReset, message)
+	ui.Printf("%s⏺%s %s\n", ColorBlue, ColorReset, message)
 }
 
 // Warning shows a warning message
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"strings"
+
+	"atempo/internal/compose"
+	"atempo/internal/registry"
+	"atempo/internal/scaffold"
+	"atempo/internal/ui"
+	"atempo/internal/utils"
+)
+
+// UpgradeCheckCommand advises on framework upgrades without changing the project
+type UpgradeCheckCommand struct {
+	*BaseCommand
+	templatesFS embed.FS
+}
+
+// NewUpgradeCheckCommand creates a new upgrade-check command
+func NewUpgradeCheckCommand(ctx *CommandContext, templatesFS embed.FS) *UpgradeCheckCommand {
+	return &UpgradeCheckCommand{
+		BaseCommand: NewBaseCommand(
+			"upgrade-check",
+			"Compare a project's framework version with the latest supported major",
+			"atempo upgrade-check [project]",
+			ctx,
+		),
+		templatesFS: templatesFS,
+	}
+}
+
+// Execute reports whether a newer supported major version is available
+func (c *UpgradeCheckCommand) Execute(ctx context.Context, args []string) error {
+	projectPath, err := resolveProjectArg(args)
+	if err != nil {
+		return err
+	}
+
+	config, err := compose.LoadAtempoConfig(projectPath)
+	if err != nil {
+		return err
+	}
+
+	framework := strings.ToLower(config.Framework)
+	version := config.Version
+
+	// Fall back to the version recorded when the project was registered
+	if version == "" || framework == "" {
+		if reg, err := registry.LoadRegistry(); err == nil {
+			for _, project := range reg.Projects {
+				if project.Path == projectPath {
+					if framework == "" {
+						framework = strings.ToLower(project.Framework)
+					}
+					if version == "" {
+						version = project.Version
+					}
+					break
+				}
+			}
+		}
+	}
+
+	if framework == "" {
+		return fmt.Errorf("no framework recorded for project at %s", projectPath)
+	}
+
+	bounds, ok := scaffold.SupportedMajorVersions(c.templatesFS, framework)
+	if !ok {
+		fmt.Printf("ℹ️  No upgrade metadata for framework '%s'\n", framework)
+		return nil
+	}
+
+	latest := bounds.Max
+	guide := upgradeGuideURL(framework, latest)
+
+	if version == "" {
+		fmt.Printf("⚠️  No %s version recorded for this project\n", framework)
+		fmt.Printf("   Latest supported major: %d\n", latest)
+		fmt.Printf("   Upgrade guide: %s\n", guide)
+		return nil
+	}
+
+	current := utils.ParseVersionPart(strings.Split(version, ".")[0])
+
+	fmt.Printf("🛠️  %s %s (latest supported major: %d)\n", framework, version, latest)
+
+	switch {
+	case current >= latest:
+		fmt.Println("✅ Project is on the latest supported major version")
+	case current < bounds.Min:
+		fmt.Printf("⚠️  Version %s is below the minimum supported major (%d)\n", version, bounds.Min)
+		ui.Printf("💡 Upgrade one major version at a time, from %d up to %d\n", current, latest)
+		ui.Printf("   Upgrade guide: %s\n", guide)
+	default:
+		fmt.Printf("⬆️  %d major version(s) behind\n", latest-current)
+		ui.Printf("💡 Upgrade one major version at a time, from %d up to %d\n", current, latest)
+		ui.Printf("   Upgrade guide: %s\n", guide)
+	}
+
+	return nil
+}
+
+// upgradeGuideURL returns the framework's official upgrade guide for a major version
+func upgradeGuideURL(framework string, major int) string {
+	switch framework {
+	case "laravel":
+		return fmt.Sprintf("https://laravel.com/docs/%d.x/upgrade", major)
+	case "django":
+		return fmt.Sprintf("https://docs.djangoproject.com/en/%d.0/howto/upgrade-version/", major)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"atempo/internal/compose"
+	"atempo/internal/ui"
+)
+
+// ValidateCommand checks a project's atempo.json without generating files
+type ValidateCommand struct {
+	*BaseCommand
+}
+
+// NewValidateCommand creates a new validate command
+func NewValidateCommand(ctx *CommandContext) *ValidateCommand {
+	return &ValidateCommand{
+		BaseCommand: NewBaseCommand(
+			"validate",
+			"Check atempo.json for errors and warnings",
+			"atempo validate [project] [--strict]",
+			ctx,
+		),
+	}
+}
+
+// Execute runs the validate command
+func (c *ValidateCommand) Execute(ctx context.Context, args []string) error {
+	// --strict turns unknown keys (usually typos) into errors
+	var strict bool
+	var positional []string
+	for _, arg := range args {
+		switch {
+		case arg == "--strict":
+			strict = true
+		case strings.HasPrefix(arg, "-"):
+			return usageErrorf("unknown flag: %s. Usage: %s", arg, c.Usage())
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectPath, err := resolveProjectArg(positional)
+	if err != nil {
+		return err
+	}
+
+	ui.Printf("→ Validating atempo.json in %s...\n", projectPath)
+
+	unknown, err := compose.FindUnknownFields(projectPath)
+	if err != nil {
+		return fmt.Errorf("atempo.json is invalid: %w", err)
+	}
+	if strict && len(unknown) > 0 {
+		for _, field := range unknown {
+			fmt.Printf("❌ %s\n", field)
+		}
+		return fmt.Errorf("atempo.json is invalid: %w (%d found)", compose.ErrUnknownFields, len(unknown))
+	}
+
+	warnings, err := compose.ValidateConfig(projectPath)
+	if err != nil {
+		return fmt.Errorf("atempo.json is invalid: %w", err)
+	}
+
+	issues, err := compose.CheckConfig(projectPath)
+	if err != nil {
+		return fmt.Errorf("atempo.json is invalid: %w", err)
+	}
+	if compose.HasErrors(issues) {
+		errorCount := printConfigIssues(issues)
+		return fmt.Errorf("atempo.json is invalid: %w (%d found)", compose.ErrInvalidConfig, errorCount)
+	}
+	for _, issue := range issues {
+		warnings = append(warnings, issue.Message)
+	}
+	for _, field := range unknown {
+		warnings = append(warnings, field.String())
+	}
+
+	for _, warning := range warnings {
+		fmt.Printf("⚠️  %s\n", warning)
+	}
+
+	if len(warnings) > 0 {
+		fmt.Printf("✅ atempo.json is valid (%d warning(s))\n", len(warnings))
+	} else {
+		fmt.Println("✅ atempo.json is valid")
+	}
+	return nil
+}
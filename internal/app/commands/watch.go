@@ -0,0 +1,196 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"atempo/internal/registry"
+	"atempo/internal/ui"
+)
+
+// defaultWatchInterval is how often 'watch' polls container health
+const defaultWatchInterval = 10 * time.Second
+
+// webhookAttempts bounds delivery retries for one status change
+const webhookAttempts = 3
+
+// WatchCommand polls a project's health and reports status transitions
+type WatchCommand struct {
+	*BaseCommand
+}
+
+// NewWatchCommand creates a new watch command
+func NewWatchCommand(ctx *CommandContext) *WatchCommand {
+	return &WatchCommand{
+		BaseCommand: NewBaseCommand(
+			"watch",
+			"Watch project health and POST status changes to a webhook",
+			"atempo watch [project] [--webhook <url>] [--interval <duration>]",
+			ctx,
+		),
+	}
+}
+
+// WatchEvent is the JSON payload sent to the webhook on a status transition
+type WatchEvent struct {
+	Project        string             `json:"project"`
+	Path           string             `json:"path"`
+	Status         string             `json:"status"`
+	PreviousStatus string             `json:"previous_status"`
+	Services       []registry.Service `json:"services"`
+	Timestamp      time.Time          `json:"timestamp"`
+}
+
+// Execute polls until interrupted, notifying on every overall status change
+func (c *WatchCommand) Execute(ctx context.Context, args []string) error {
+	webhook := ""
+	interval := defaultWatchInterval
+	var positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--webhook" || strings.HasPrefix(arg, "--webhook="):
+			value := strings.TrimPrefix(arg, "--webhook=")
+			if arg == "--webhook" {
+				if i+1 >= len(args) {
+					return usageErrorf("--webhook requires a URL")
+				}
+				value = args[i+1]
+				i++
+			}
+			parsed, err := url.Parse(value)
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				return usageErrorf("invalid --webhook URL '%s': expected http(s)://host/path", value)
+			}
+			webhook = value
+		case arg == "--interval" || strings.HasPrefix(arg, "--interval="):
+			value := strings.TrimPrefix(arg, "--interval=")
+			if arg == "--interval" {
+				if i+1 >= len(args) {
+					return usageErrorf("--interval requires a value (e.g. 10s)")
+				}
+				value = args[i+1]
+				i++
+			}
+			duration, err := time.ParseDuration(value)
+			if err != nil || duration <= 0 {
+				return usageErrorf("invalid --interval '%s': expected a positive duration such as 10s or 1m", value)
+			}
+			interval = duration
+		case strings.HasPrefix(arg, "-"):
+			return usageErrorf("unknown flag: %s. Usage: %s", arg, c.Usage())
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	projectPath, err := resolveProjectArg(positional)
+	if err != nil {
+		return err
+	}
+	projectName := filepath.Base(projectPath)
+	if len(positional) > 0 {
+		projectName = positional[0]
+	}
+
+	// Stop cleanly on Ctrl+C
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	ui.Printf("👀 Watching %s every %s (Ctrl+C to stop)\n", projectName, interval)
+	if webhook != "" {
+		ui.Printf("→ Status changes will be posted to %s\n", webhook)
+	}
+
+	previous := ""
+	for {
+		status, services := registry.CheckProjectHealth(projectPath)
+
+		if status != previous {
+			timestamp := time.Now()
+			if previous == "" {
+				fmt.Printf("[%s] %s is %s\n", timestamp.Format("15:04:05"), projectName, status)
+			} else {
+				fmt.Printf("[%s] %s changed: %s → %s\n", timestamp.Format("15:04:05"), projectName, previous, status)
+
+				// The first observation is a baseline, not a transition
+				if webhook != "" {
+					if services == nil {
+						services = []registry.Service{} // Encode as [] rather than null
+					}
+					event := WatchEvent{
+						Project:        projectName,
+						Path:           projectPath,
+						Status:         status,
+						PreviousStatus: previous,
+						Services:       services,
+						Timestamp:      timestamp.UTC(),
+					}
+					if err := postWatchEvent(ctx, webhook, event); err != nil {
+						fmt.Printf("⚠️  Webhook delivery failed: %v\n", err)
+					}
+				}
+			}
+			previous = status
+		}
+
+		select {
+		case <-ctx.Done():
+			fmt.Println("\nStopped watching")
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// postWatchEvent POSTs the event as JSON, retrying with backoff on errors and non-2xx responses
+func postWatchEvent(ctx context.Context, webhook string, event WatchEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	var lastErr error
+
+	for attempt := 1; attempt <= webhookAttempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt-1) * 2 * time.Second):
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(payload))
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "atempo-watch")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+		lastErr = fmt.Errorf("webhook returned %s", resp.Status)
+	}
+
+	return fmt.Errorf("giving up after %d attempts: %w", webhookAttempts, lastErr)
+}
@@ -0,0 +1,76 @@
+package compose
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// HostAccessEnvFile is written next to docker-compose.yml with the host-facing
+// address of every published service port
+const HostAccessEnvFile = ".env.atempo"
+
+// writeHostAccessEnv writes the host address and external port of each service
+// with a published port, so external tools (database GUIs, redis clients) can
+// connect. The framework .env is left alone because the app itself talks to
+// services over the compose network using their container hostnames.
+func writeHostAccessEnv(projectPath string, config *AtempoConfig) error {
+	envPath := filepath.Join(projectPath, HostAccessEnvFile)
+
+	var lines []string
+	for _, serviceName := range sortedServiceNames(config.Services) {
+		for _, spec := range config.Services[serviceName].Ports {
+			mapping, ok := ParsePortMapping(spec)
+			if !ok {
+				continue
+			}
+
+			host := mapping.HostIP
+			if host == "" || host == "0.0.0.0" {
+				host = "127.0.0.1"
+			}
+
+			prefix := "ATEMPO_" + envName(serviceName)
+			lines = append(lines,
+				fmt.Sprintf("# %s (container port %d)", serviceName, mapping.ContainerPort),
+				fmt.Sprintf("%s_HOST=%s", prefix, host),
+				fmt.Sprintf("%s_PORT=%d", prefix, mapping.HostPort),
+			)
+			// Only the first published port of a service gets the short names
+			break
+		}
+	}
+
+	if len(lines) == 0 {
+		// Nothing is published; remove a stale file from an earlier configuration
+		if err := os.Remove(envPath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove %s: %w", HostAccessEnvFile, err)
+		}
+		return nil
+	}
+
+	content := "# Generated by atempo from atempo.json - do not edit.\n" +
+		"# Host-side connection details for external tools. Containers keep using\n" +
+		"# the service hostnames from .env (e.g. DB_HOST=mysql).\n\n" +
+		strings.Join(lines, "\n") + "\n"
+
+	if err := os.WriteFile(envPath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", HostAccessEnvFile, err)
+	}
+	return nil
+}
+
+// envName converts a service name into an environment variable name fragment
+func envName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
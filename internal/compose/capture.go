@@ -0,0 +1,245 @@
+package compose
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManualAddition is an environment variable or volume added by hand to a service
+// in docker-compose.yml that atempo.json also defines
+type ManualAddition struct {
+	Service string
+	Kind    string // "environment" or "volume"
+	Key     string // Variable name (environment only)
+	Value   string // Variable value, or the volume spec
+}
+
+// String describes the addition, e.g. for reconfigure output
+func (a ManualAddition) String() string {
+	if a.Kind == "environment" {
+		return fmt.Sprintf("service '%s': environment %s=%s", a.Service, a.Key, a.Value)
+	}
+	return fmt.Sprintf("service '%s': volume %s", a.Service, a.Value)
+}
+
+// composeSettings is the part of a docker-compose.yml service compared by FindManualAdditions
+type composeSettings struct {
+	Services map[string]struct {
+		Environment interface{}   `yaml:"environment"` // map, or list of KEY=VALUE
+		Volumes     []interface{} `yaml:"volumes"`     // Short-syntax strings; long syntax is skipped
+	} `yaml:"services"`
+}
+
+// FindManualAdditions compares docker-compose.yml with what atempo.json generates and
+// reports environment variables (added or changed) and volumes that were added by
+// hand to services atempo.json defines. Services atempo.json lacks are left to --merge.
+func FindManualAdditions(projectPath string, opts GenerateOptions) ([]ManualAddition, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, "docker-compose.yml"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read docker-compose.yml: %w", err)
+	}
+
+	var existing composeSettings
+	if err := yaml.Unmarshal(data, &existing); err != nil {
+		return nil, fmt.Errorf("failed to parse docker-compose.yml: %w", err)
+	}
+
+	// Compare against the rendered YAML so both sides have the same shape
+	opts.Merge = false
+	content, _, err := RenderDockerCompose(projectPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	var generated composeSettings
+	if err := yaml.Unmarshal([]byte(content), &generated); err != nil {
+		return nil, fmt.Errorf("failed to parse generated docker-compose.yml: %w", err)
+	}
+
+	names := make([]string, 0, len(existing.Services))
+	for name := range existing.Services {
+		if _, defined := generated.Services[name]; defined {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var additions []ManualAddition
+	for _, name := range names {
+		current, expected := existing.Services[name], generated.Services[name]
+
+		currentEnv, expectedEnv := environmentMap(current.Environment), environmentMap(expected.Environment)
+		keys := make([]string, 0, len(currentEnv))
+		for key := range currentEnv {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if value, generatedValue := expectedEnv[key]; !generatedValue || value != currentEnv[key] {
+				additions = append(additions, ManualAddition{Service: name, Kind: "environment", Key: key, Value: currentEnv[key]})
+			}
+		}
+
+		expectedVolumes := make(map[string]bool)
+		for _, volume := range expected.Volumes {
+			if spec, ok := volume.(string); ok {
+				expectedVolumes[spec] = true
+			}
+		}
+		for _, volume := range current.Volumes {
+			if spec, ok := volume.(string); ok && !expectedVolumes[spec] {
+				additions = append(additions, ManualAddition{Service: name, Kind: "volume", Value: spec})
+			}
+		}
+	}
+	return additions, nil
+}
+
+// CaptureManualAdditions writes the additions into atempo.json so they survive
+// regeneration. Named volumes a captured mount uses are declared as well. Fields
+// AtempoConfig doesn't model (such as the installer) and the key order are preserved.
+func CaptureManualAdditions(projectPath string, additions []ManualAddition) error {
+	atempoJsonPath := filepath.Join(projectPath, "atempo.json")
+
+	data, err := os.ReadFile(atempoJsonPath)
+	if err != nil {
+		return fmt.Errorf("failed to read atempo.json: %w", err)
+	}
+
+	raw, err := ParseJSONObject(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse atempo.json: %w", err)
+	}
+	services, err := raw.Object("services")
+	if err != nil {
+		return fmt.Errorf("failed to parse atempo.json: %w", err)
+	}
+
+	for _, addition := range additions {
+		var service *JSONObject
+		if services != nil {
+			if service, err = services.Object(addition.Service); err != nil {
+				return fmt.Errorf("failed to parse atempo.json: %w", err)
+			}
+		}
+		if service == nil {
+			return fmt.Errorf("service '%s' is not defined in atempo.json", addition.Service)
+		}
+
+		switch addition.Kind {
+		case "environment":
+			environment, err := service.Object("environment")
+			if err != nil {
+				return fmt.Errorf("failed to parse atempo.json: %w", err)
+			}
+			if environment == nil {
+				environment = NewJSONObject()
+			}
+			if err := environment.Set(addition.Key, addition.Value); err != nil {
+				return err
+			}
+			if err := service.Set("environment", environment); err != nil {
+				return err
+			}
+		case "volume":
+			var volumes []interface{}
+			if value, ok := service.Get("volumes"); ok {
+				if err := json.Unmarshal(value, &volumes); err != nil {
+					return fmt.Errorf("failed to parse atempo.json: service '%s' volumes: %w", addition.Service, err)
+				}
+			}
+			if err := service.Set("volumes", append(volumes, addition.Value)); err != nil {
+				return err
+			}
+
+			if host, _, ok := splitVolumeSpec(addition.Value); ok && isNamedVolume(host) {
+				declared, err := raw.Object("volumes")
+				if err != nil {
+					return fmt.Errorf("failed to parse atempo.json: %w", err)
+				}
+				if declared == nil {
+					declared = NewJSONObject()
+				}
+				if _, exists := declared.Get(host); !exists {
+					if err := declared.Set(host, map[string]interface{}{}); err != nil {
+						return err
+					}
+				}
+				if err := raw.Set("volumes", declared); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := services.Set(addition.Service, service); err != nil {
+			return err
+		}
+	}
+	if services != nil {
+		if err := raw.Set("services", services); err != nil {
+			return err
+		}
+	}
+
+	data, err = MarshalConfigJSON(raw)
+	if err != nil {
+		return fmt.Errorf("failed to marshal atempo.json: %w", err)
+	}
+	return os.WriteFile(atempoJsonPath, data, 0644)
+}
+
+// MatchesGenerated reports whether docker-compose.yml has the same content as the
+// file atempo.json generates now, ignoring formatting, comments and key order. After
+// a capture this tells whether every hand edit was moved into atempo.json.
+func MatchesGenerated(projectPath string, opts GenerateOptions) (bool, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, "docker-compose.yml"))
+	if err != nil {
+		return false, fmt.Errorf("failed to read docker-compose.yml: %w", err)
+	}
+
+	opts.Merge = false
+	content, _, err := RenderDockerCompose(projectPath, opts)
+	if err != nil {
+		return false, err
+	}
+
+	var existing, generated interface{}
+	if err := yaml.Unmarshal(data, &existing); err != nil {
+		return false, fmt.Errorf("failed to parse docker-compose.yml: %w", err)
+	}
+	if err := yaml.Unmarshal([]byte(content), &generated); err != nil {
+		return false, fmt.Errorf("failed to parse generated docker-compose.yml: %w", err)
+	}
+	return reflect.DeepEqual(existing, generated), nil
+}
+
+// environmentMap normalizes a compose environment block, in map or KEY=VALUE list
+// form, to a map. Variables without a value map to "".
+func environmentMap(environment interface{}) map[string]string {
+	values := make(map[string]string)
+	switch env := environment.(type) {
+	case map[string]interface{}:
+		for key, value := range env {
+			if value != nil {
+				values[key] = fmt.Sprint(value)
+			} else {
+				values[key] = ""
+			}
+		}
+	case []interface{}:
+		for _, entry := range env {
+			key, value, _ := strings.Cut(fmt.Sprint(entry), "=")
+			values[key] = value
+		}
+	}
+	return values
+}
@@ -0,0 +1,70 @@
+package compose
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCaptureManualAdditionsKeepsKeyOrder(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "atempo.json", `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {
+    "worker": {"type": "image", "image": "php:8.3-fpm", "command": "php artisan queue:work && echo <done>"},
+    "app": {"type": "image", "image": "php:8.3-fpm", "environment": {"Z_LAST": "1"}}
+  }
+}`)
+
+	additions := []ManualAddition{
+		{Service: "app", Kind: "environment", Key: "A_FIRST", Value: "2"},
+		{Service: "worker", Kind: "volume", Value: "cache:/tmp/cache"},
+	}
+	if err := CaptureManualAdditions(dir, additions); err != nil {
+		t.Fatalf("CaptureManualAdditions: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "atempo.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {
+    "worker": {
+      "type": "image",
+      "image": "php:8.3-fpm",
+      "command": "php artisan queue:work && echo <done>",
+      "volumes": [
+        "cache:/tmp/cache"
+      ]
+    },
+    "app": {
+      "type": "image",
+      "image": "php:8.3-fpm",
+      "environment": {
+        "Z_LAST": "1",
+        "A_FIRST": "2"
+      }
+    }
+  },
+  "volumes": {
+    "cache": {}
+  }
+}`
+	if string(data) != want {
+		t.Errorf("atempo.json =\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestCaptureManualAdditionsUnknownService(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "atempo.json", `{"framework": "laravel", "services": {}}`)
+
+	err := CaptureManualAdditions(dir, []ManualAddition{{Service: "app", Kind: "environment", Key: "A", Value: "1"}})
+	if err == nil {
+		t.Fatal("expected an error for a service missing from atempo.json")
+	}
+}
@@ -0,0 +1,187 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Severities of the issues reported by CheckConfig
+const (
+	SeverityError   = "error"   // docker would reject the generated file
+	SeverityWarning = "warning" // Generated file works, but probably not as intended
+)
+
+// ConfigIssue is a problem in atempo.json found by CheckConfig
+type ConfigIssue struct {
+	Severity string
+	Message  string
+}
+
+// String describes the issue, e.g. for validate output
+func (i ConfigIssue) String() string {
+	return i.Severity + ": " + i.Message
+}
+
+// ErrInvalidConfig is returned when atempo.json has error-severity issues
+var ErrInvalidConfig = errors.New("atempo.json has errors")
+
+// CheckConfig looks for mistakes that docker-compose would only report at 'up' time,
+// with a less helpful message: host ports used twice, undeclared named volumes and
+// depends_on entries that are unknown or form a cycle. Issues are sorted, errors first.
+func CheckConfig(projectPath string) ([]ConfigIssue, error) {
+	config, err := LoadAtempoConfig(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []ConfigIssue
+	issues = append(issues, checkDuplicatePorts(config)...)
+	issues = append(issues, checkVolumes(config, projectPath)...)
+	issues = append(issues, checkDependencies(config)...)
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Severity == SeverityError && issues[j].Severity != SeverityError
+	})
+	return issues, nil
+}
+
+// HasErrors reports whether any issue has error severity
+func HasErrors(issues []ConfigIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDuplicatePorts reports host ports bound by more than one mapping
+func checkDuplicatePorts(config *AtempoConfig) []ConfigIssue {
+	var issues []ConfigIssue
+	owners := make(map[string]string)
+
+	for _, serviceName := range sortedServiceNames(config.Services) {
+		for _, spec := range config.Services[serviceName].Ports {
+			mapping, ok := ParsePortMapping(spec)
+			if !ok {
+				continue
+			}
+
+			key := fmt.Sprintf("%d/%s", mapping.HostPort, mapping.Protocol)
+			if owner, taken := owners[key]; taken {
+				issues = append(issues, ConfigIssue{
+					Severity: SeverityError,
+					Message:  fmt.Sprintf("host port %d is used by both '%s' and '%s'", mapping.HostPort, owner, serviceName),
+				})
+				continue
+			}
+			owners[key] = serviceName
+		}
+	}
+	return issues
+}
+
+// checkVolumes reports named volumes that services use without declaring them, and
+// declared volumes no service uses
+func checkVolumes(config *AtempoConfig, projectPath string) []ConfigIssue {
+	var issues []ConfigIssue
+	used := make(map[string]bool)
+
+	for _, serviceName := range sortedServiceNames(config.Services) {
+		for _, spec := range config.Services[serviceName].Volumes {
+			host, _, ok := splitVolumeSpec(spec)
+			if !ok || !isNamedVolume(host) || strings.Contains(host, "{{") {
+				continue
+			}
+			used[host] = true
+			if _, declared := config.Volumes[host]; declared {
+				continue
+			}
+			hint := `add it to "volumes" in atempo.json`
+			if info, err := os.Stat(filepath.Join(projectPath, host)); err == nil && info.IsDir() {
+				hint += fmt.Sprintf(", or use './%s' to bind-mount the project directory", host)
+			}
+			issues = append(issues, ConfigIssue{
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("service '%s' uses undeclared volume '%s' (%s)", serviceName, host, hint),
+			})
+		}
+	}
+
+	var unused []string
+	for volumeName := range config.Volumes {
+		if !used[volumeName] {
+			unused = append(unused, volumeName)
+		}
+	}
+	sort.Strings(unused)
+	for _, volumeName := range unused {
+		issues = append(issues, ConfigIssue{
+			Severity: SeverityWarning,
+			Message:  fmt.Sprintf("volume '%s' is declared but no service uses it", volumeName),
+		})
+	}
+	return issues
+}
+
+// checkDependencies reports depends_on entries naming unknown services, and cycles
+func checkDependencies(config *AtempoConfig) []ConfigIssue {
+	var issues []ConfigIssue
+	names := sortedServiceNames(config.Services)
+
+	for _, serviceName := range names {
+		for _, dependency := range config.Services[serviceName].DependsOn {
+			if _, exists := config.Services[dependency]; !exists {
+				issues = append(issues, ConfigIssue{
+					Severity: SeverityError,
+					Message:  fmt.Sprintf("service '%s' depends on undefined service '%s'", serviceName, dependency),
+				})
+			}
+		}
+	}
+
+	// Depth-first search; reaching a service that is still on the path closes a cycle
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int)
+	var path []string
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		path = append(path, name)
+		for _, dependency := range config.Services[name].DependsOn {
+			if _, exists := config.Services[dependency]; !exists {
+				continue
+			}
+			switch state[dependency] {
+			case unvisited:
+				visit(dependency)
+			case visiting:
+				start := 0
+				for path[start] != dependency {
+					start++
+				}
+				cycle := append(append([]string(nil), path[start:]...), dependency)
+				issues = append(issues, ConfigIssue{
+					Severity: SeverityError,
+					Message:  fmt.Sprintf("depends_on cycle: %s", strings.Join(cycle, " → ")),
+				})
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = done
+	}
+	for _, name := range names {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+	return issues
+}
@@ -0,0 +1,126 @@
+package compose
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Command forms accepted by the "command_form" service field
+const (
+	CommandFormShell = "shell" // Single string, e.g. "celery -A config worker"
+	CommandFormExec  = "exec"  // Argument list, e.g. ["celery", "-A", "config", "worker"]
+)
+
+// shellMetaChars are characters that only make sense when a command is interpreted by a shell
+const shellMetaChars = "|&;<>$`*?(){}"
+
+// normalizeCommand converts a service command into a consistent compose representation.
+// JSON decoding yields []interface{} for arrays, so those are converted to []string.
+// When form is set the command is converted to that form; otherwise the declared form
+// is kept and a warning is returned if a string command looks like it wanted exec form.
+func normalizeCommand(command interface{}, form string) (interface{}, string, error) {
+	var args []string
+	isString := false
+
+	switch value := command.(type) {
+	case nil:
+		return nil, "", nil
+	case string:
+		isString = true
+	case []string:
+		args = value
+	case []interface{}:
+		args = make([]string, len(value))
+		for i, part := range value {
+			str, ok := part.(string)
+			if !ok {
+				return nil, "", fmt.Errorf("command argument %d must be a string, got %T", i, part)
+			}
+			args[i] = str
+		}
+	default:
+		return nil, "", fmt.Errorf("command must be a string or an array of strings, got %T", command)
+	}
+
+	switch form {
+	case CommandFormShell:
+		if isString {
+			return command.(string), "", nil
+		}
+		return joinCommandArgs(args), "", nil
+	case CommandFormExec:
+		if isString {
+			return splitCommandLine(command.(string)), "", nil
+		}
+		return args, "", nil
+	case "":
+		if isString {
+			str := command.(string)
+			if strings.Contains(strings.TrimSpace(str), " ") && !strings.ContainsAny(str, shellMetaChars) {
+				return str, fmt.Sprintf("command %q is a multi-word string (shell form); use an array or set \"command_form\": \"exec\" if exec form is intended", str), nil
+			}
+			return str, "", nil
+		}
+		return args, "", nil
+	default:
+		return nil, "", fmt.Errorf("invalid command_form %q (expected %q or %q)", form, CommandFormShell, CommandFormExec)
+	}
+}
+
+// splitCommandLine splits a command string into arguments, honouring single quotes,
+// double quotes and backslash escapes the same way a POSIX shell would
+func splitCommandLine(command string) []string {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, r := range command {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args
+}
+
+// joinCommandArgs joins arguments into a single command string, quoting where needed
+func joinCommandArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\n'\"\\"+shellMetaChars) {
+			quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		} else {
+			quoted[i] = arg
+		}
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,69 @@
+package compose
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeCommand(t *testing.T) {
+	tests := []struct {
+		name        string
+		command     interface{}
+		form        string
+		want        interface{}
+		wantWarning string // Substring of the expected warning; "" means none
+		wantErr     bool
+	}{
+		{name: "nil", command: nil, want: nil},
+		{name: "single word string", command: "php-fpm", want: "php-fpm"},
+		{name: "shell pipeline string", command: "sh -c 'migrate && serve'", want: "sh -c 'migrate && serve'"},
+		{
+			name:        "multi-word string warns",
+			command:     "celery -A config worker",
+			want:        "celery -A config worker",
+			wantWarning: "multi-word string (shell form)",
+		},
+		{name: "list from atempo.json", command: []interface{}{"celery", "-A", "config", "worker"}, want: []string{"celery", "-A", "config", "worker"}},
+		{name: "string list", command: []string{"php", "artisan", "serve"}, want: []string{"php", "artisan", "serve"}},
+		{name: "string to exec", command: `python manage.py runserver "0.0.0.0:8000"`, form: CommandFormExec, want: []string{"python", "manage.py", "runserver", "0.0.0.0:8000"}},
+		{name: "quoted string to exec", command: `echo 'a b' "c\"d" e\ f`, form: CommandFormExec, want: []string{"echo", "a b", `c"d`, "e f"}},
+		{name: "list to shell", command: []interface{}{"echo", "hello world", "it's", ""}, form: CommandFormShell, want: `echo 'hello world' 'it'\''s' ''`},
+		{name: "string stays shell", command: "npm run dev", form: CommandFormShell, want: "npm run dev"},
+		{name: "list stays exec", command: []interface{}{"npm", "run", "dev"}, form: CommandFormExec, want: []string{"npm", "run", "dev"}},
+		{name: "non-string argument", command: []interface{}{"sleep", 5.0}, wantErr: true},
+		{name: "unsupported type", command: 42.0, wantErr: true},
+		{name: "invalid form", command: "php-fpm", form: "script", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, warning, err := normalizeCommand(tt.command, tt.form)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %#v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("command = %#v, want %#v", got, tt.want)
+			}
+			switch {
+			case tt.wantWarning == "" && warning != "":
+				t.Errorf("unexpected warning: %s", warning)
+			case tt.wantWarning != "" && !strings.Contains(warning, tt.wantWarning):
+				t.Errorf("warning = %q, want one containing %q", warning, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestShellAndExecFormsRoundTrip(t *testing.T) {
+	args := []string{"sh", "-c", "echo $HOME && ls", "it's"}
+	if got := splitCommandLine(joinCommandArgs(args)); !reflect.DeepEqual(got, args) {
+		t.Errorf("round trip = %#v, want %#v", got, args)
+	}
+}
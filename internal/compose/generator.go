@@ -2,9 +2,11 @@ package compose
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -19,22 +21,34 @@ type AtempoConfig struct {
 	Volumes   map[string]Volume      `json:"volumes,omitempty"`
 	Networks  map[string]Network     `json:"networks,omitempty"`
 	Version   string                 `json:"version,omitempty"`
+	PrivilegedPorts string           `json:"privileged_ports,omitempty"` // "warn" (default), "remap" or "allow"
+	ComposeVersion  string           `json:"compose_version,omitempty"`  // e.g. "3.8" (default) or "none" to omit
+	WorkingDir      string           `json:"working-dir,omitempty"`      // Framework project root in the app container
+	PostInstall     []PostInstallHook `json:"post_install,omitempty"`    // Replaces the framework's default setup commands
+	Tags            []string         `json:"tags,omitempty"`             // Groups projects for 'atempo start-all --tag'
+	ContainerPrefix string           `json:"container_prefix,omitempty"` // Prefix for container names (defaults to name)
 }
 
 // Service represents a Docker service definition
 type Service struct {
-	Type        string            `json:"type"` // "image" or "build"
-	Image       string            `json:"image,omitempty"`
-	Dockerfile  string            `json:"dockerfile,omitempty"`
-	Context     string            `json:"context,omitempty"`
-	Command     interface{}       `json:"command,omitempty"` // string or []string
-	WorkingDir  string            `json:"working_dir,omitempty"`
-	Ports       []string          `json:"ports,omitempty"`
-	Volumes     []string          `json:"volumes,omitempty"`
-	Environment map[string]string `json:"environment,omitempty"`
-	DependsOn   []string          `json:"depends_on,omitempty"`
-	Restart     string            `json:"restart,omitempty"`
-	Networks    []string          `json:"networks,omitempty"`
+	Type        string                 `json:"type"` // "image" or "build"
+	Image       string                 `json:"image,omitempty"`
+	Dockerfile  string                 `json:"dockerfile,omitempty"`
+	Context     string                 `json:"context,omitempty"`
+	Command     interface{}            `json:"command,omitempty"` // string or []string
+	WorkingDir  string                 `json:"working_dir,omitempty"`
+	Ports       []string               `json:"ports,omitempty"`
+	Volumes     []string               `json:"volumes,omitempty"`
+	Environment map[string]string      `json:"environment,omitempty"`
+	DependsOn   []string               `json:"depends_on,omitempty"`
+	Restart     string                 `json:"restart,omitempty"`
+	Networks    ServiceNetworks        `json:"networks,omitempty"`     // list or map with aliases
+	CommandForm string                 `json:"command_form,omitempty"` // "shell" or "exec"
+	PullPolicy  string                 `json:"pull_policy,omitempty"`  // "always", "missing" or "never"
+	Ulimits     map[string]interface{} `json:"ulimits,omitempty"`      // number or {"soft": n, "hard": n}
+	Labels      map[string]string      `json:"labels,omitempty"`
+	Oneshot     bool                   `json:"oneshot,omitempty"` // Runs once and exits (e.g. schema import); dependents wait for success
+	Shell       string                 `json:"shell,omitempty"`   // Opened by 'atempo docker exec <service>' without a command (default bash)
 }
 
 // Volume represents a Docker volume definition
@@ -50,21 +64,31 @@ type Network struct {
 	Driver     string            `json:"driver,omitempty"`
 	DriverOpts map[string]string `json:"driver_opts,omitempty"`
 	External   bool              `json:"external,omitempty"`
+	IPAM       *NetworkIPAM      `json:"ipam,omitempty"` // Fixed address range, e.g. for ipv4_address
 }
 
 // DockerCompose represents the docker-compose.yml structure
 type DockerCompose struct {
-	Version  string                 `yaml:"version"`
+	Version  string                 `yaml:"version,omitempty"`
 	Services map[string]interface{} `yaml:"services"`
 	Volumes  map[string]interface{} `yaml:"volumes,omitempty"`
 	Networks map[string]interface{} `yaml:"networks,omitempty"`
 }
 
+// containerNamePattern matches names docker accepts for containers
+var containerNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// ErrConfigNotFound is returned when a project directory has no atempo.json
+var ErrConfigNotFound = errors.New("atempo.json not found")
+
 // LoadAtempoConfig loads and parses the atempo.json file
 func LoadAtempoConfig(projectPath string) (*AtempoConfig, error) {
 	atempoJsonPath := filepath.Join(projectPath, "atempo.json")
 	
 	data, err := os.ReadFile(atempoJsonPath)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w in %s", ErrConfigNotFound, projectPath)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to read atempo.json: %w", err)
 	}
@@ -77,15 +101,122 @@ func LoadAtempoConfig(projectPath string) (*AtempoConfig, error) {
 	return &config, nil
 }
 
+// GenerateOptions customizes docker-compose.yml generation
+type GenerateOptions struct {
+	// ImageTag overrides the image of build services. A plain tag (e.g. "1.4.2") is
+	// appended to the generated image name; a full reference (containing ':' or '/')
+	// replaces it, with "{service}" substituted by the service name.
+	ImageTag string
+
+	// ComposeVersion overrides atempo.json's compose_version. "none" omits the
+	// version field for the modern Compose Specification.
+	ComposeVersion string
+
+	// Merge keeps services, volumes and networks that were added to the existing
+	// docker-compose.yml by hand and are not defined in atempo.json
+	Merge bool
+
+	// Strict fails generation when atempo.json has keys no setting reads
+	Strict bool
+}
+
 // GenerateDockerCompose generates a docker-compose.yml from atempo.json
 func GenerateDockerCompose(projectPath string) error {
+	return GenerateDockerComposeWithOptions(projectPath, GenerateOptions{})
+}
+
+// GenerateDockerComposeWithOptions generates a docker-compose.yml from atempo.json
+func GenerateDockerComposeWithOptions(projectPath string, opts GenerateOptions) error {
+	if opts.Strict {
+		if err := checkStrict(projectPath); err != nil {
+			return err
+		}
+	}
+
 	config, err := LoadAtempoConfig(projectPath)
 	if err != nil {
 		return err
 	}
 
+	compose, warnings, err := buildDockerCompose(projectPath, config, opts)
+	if err != nil {
+		return err
+	}
+	for _, warning := range warnings {
+		fmt.Printf("Warning: %s\n", warning)
+	}
+
+	// Write docker-compose.yml
+	composePath := filepath.Join(projectPath, "docker-compose.yml")
+	if err := writeDockerCompose(compose, composePath); err != nil {
+		return err
+	}
+
+	// Surface host ports for external tools without touching the framework .env
+	return writeHostAccessEnv(projectPath, config)
+}
+
+// RenderDockerCompose returns the docker-compose.yml content atempo.json would
+// generate, along with any warnings, without writing files
+func RenderDockerCompose(projectPath string, opts GenerateOptions) (string, []string, error) {
+	if opts.Strict {
+		if err := checkStrict(projectPath); err != nil {
+			return "", nil, err
+		}
+	}
+
+	config, err := LoadAtempoConfig(projectPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	compose, warnings, err := buildDockerCompose(projectPath, config, opts)
+	if err != nil {
+		return "", nil, err
+	}
+
+	content, err := marshalDockerCompose(compose)
+	if err != nil {
+		return "", nil, err
+	}
+	return content, warnings, nil
+}
+
+// ValidateConfig checks atempo.json without writing any files. It returns the
+// warnings generation would print, or an error if generation would fail.
+func ValidateConfig(projectPath string) ([]string, error) {
+	config, err := LoadAtempoConfig(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	_, warnings, err := buildDockerCompose(projectPath, config, GenerateOptions{})
+	if err != nil {
+		return warnings, err
+	}
+
+	return warnings, validatePostInstallHooks(config)
+}
+
+// buildDockerCompose converts an atempo config into a compose document. Ports may be
+// remapped in config according to its privileged_ports policy.
+func buildDockerCompose(projectPath string, config *AtempoConfig, opts GenerateOptions) (*DockerCompose, []string, error) {
+	if err := validateImageTag(opts.ImageTag, config.Services); err != nil {
+		return nil, nil, err
+	}
+
+	version, err := resolveComposeVersion(opts.ComposeVersion, config.ComposeVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	warnings, err := applyPrivilegedPortPolicy(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	compose := &DockerCompose{
-		Version:  "3.8",
+		Version:  version,
 		Services: make(map[string]interface{}),
 		Volumes:  make(map[string]interface{}),
 		Networks: make(map[string]interface{}),
@@ -97,50 +228,122 @@ func GenerateDockerCompose(projectPath string) error {
 		projectName = filepath.Base(projectPath)
 	}
 
+	// Containers may use a shorter prefix than the registry name
+	containerPrefix := projectName
+	if config.ContainerPrefix != "" {
+		if !containerNamePattern.MatchString(config.ContainerPrefix) {
+			return nil, nil, fmt.Errorf("invalid container_prefix '%s': use letters, digits, '_', '.' or '-', starting with a letter or digit", config.ContainerPrefix)
+		}
+		containerPrefix = config.ContainerPrefix
+	}
+
+	// Template variables resolve to the real project name even before scaffold sets it
+	templateProject := projectName
+	if strings.Contains(templateProject, "{{") {
+		templateProject = filepath.Base(projectPath)
+	}
+
 	// Convert services
-	for serviceName, service := range config.Services {
-		dockerService := convertService(service, serviceName, projectName, config.Framework)
+	for _, serviceName := range sortedServiceNames(config.Services) {
+		service := config.Services[serviceName]
+		// The app service defaults to the framework's working directory
+		if service.Type == "build" && service.WorkingDir == "" && serviceName == AppService(config.Framework) {
+			service.WorkingDir = config.WorkingDir
+		}
+
+		vars := templateVars(templateProject, projectPath, config.Framework, serviceName)
+		for _, warning := range expandServiceTemplates(&service, vars) {
+			warnings = append(warnings, fmt.Sprintf("service '%s': %s", serviceName, warning))
+		}
+
+		// Copy before normalizing so config.Services keeps the user's spelling
+		service.Volumes = append([]string(nil), service.Volumes...)
+		for _, warning := range normalizeServiceVolumes(&service, projectPath, config.Volumes) {
+			warnings = append(warnings, fmt.Sprintf("service '%s': %s", serviceName, warning))
+		}
+
+		dockerService, serviceWarnings, err := convertService(service, serviceName, projectName, containerPrefix, config.Framework, opts.ImageTag)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid service '%s': %w", serviceName, err)
+		}
+		for _, warning := range serviceWarnings {
+			warnings = append(warnings, fmt.Sprintf("service '%s': %s", serviceName, warning))
+		}
 		compose.Services[serviceName] = dockerService
 	}
 
+	if err := applyOneshotDependencies(config.Services, compose.Services); err != nil {
+		return nil, nil, err
+	}
+
 	// Convert volumes
 	for volumeName, volume := range config.Volumes {
 		compose.Volumes[volumeName] = convertVolume(volume)
 	}
 
-	// Convert networks
+	// Convert explicitly declared networks
 	for networkName, network := range config.Networks {
+		if err := validateNetworkIPAM(network); err != nil {
+			return nil, nil, fmt.Errorf("invalid network '%s': %w", networkName, err)
+		}
 		compose.Networks[networkName] = convertNetwork(network)
 	}
 
-	// Add default network if none specified
-	if len(compose.Networks) == 0 {
-		compose.Networks[config.Framework] = map[string]interface{}{
-			"driver": "bridge",
+	// Services that declare no networks are attached to the default network,
+	// which is only synthesized when atempo.json doesn't already declare it
+	defaultNetwork := config.Framework
+	for serviceName, service := range config.Services {
+		serviceMap := compose.Services[serviceName].(map[string]interface{})
+
+		if len(service.Networks) == 0 {
+			if _, declared := compose.Networks[defaultNetwork]; !declared {
+				compose.Networks[defaultNetwork] = map[string]interface{}{
+					"driver": "bridge",
+				}
+			}
+			serviceMap["networks"] = []string{defaultNetwork}
+			continue
 		}
-		
-		// Add network to all services
-		for _, serviceInterface := range compose.Services {
-			if serviceMap, ok := serviceInterface.(map[string]interface{}); ok {
-				serviceMap["networks"] = []string{config.Framework}
+
+		for _, networkName := range service.Networks.Names() {
+			if _, declared := config.Networks[networkName]; !declared {
+				return nil, nil, fmt.Errorf("service '%s' references undeclared network '%s' (add it to \"networks\" in atempo.json)", serviceName, networkName)
 			}
 		}
 	}
 
-	// Write docker-compose.yml
-	composePath := filepath.Join(projectPath, "docker-compose.yml")
-	return writeDockerCompose(compose, composePath)
+	if opts.Merge {
+		mergeWarnings, err := mergeManualAdditions(projectPath, compose)
+		if err != nil {
+			return nil, nil, err
+		}
+		warnings = append(warnings, mergeWarnings...)
+	}
+
+	return compose, warnings, nil
+}
+
+// AppService returns the name of the framework's application service
+func AppService(framework string) string {
+	switch framework {
+	case "laravel":
+		return "app"
+	case "django":
+		return "web"
+	default:
+		return ""
+	}
 }
 
 // convertService converts a Atempo service to Docker Compose service
-func convertService(service Service, serviceName, projectName, framework string) map[string]interface{} {
+func convertService(service Service, serviceName, projectName, containerPrefix, framework, imageTag string) (map[string]interface{}, []string, error) {
 	dockerService := make(map[string]interface{})
+	var warnings []string
 
 	// Handle build vs image
 	if service.Type == "build" {
 		// Generate project-specific image name
-		imageName := fmt.Sprintf("%s-%s-%s", projectName, framework, serviceName)
-		dockerService["image"] = imageName
+		dockerService["image"] = buildImageName(projectName, framework, serviceName, imageTag)
 		
 		if service.Context != "" {
 			dockerService["build"] = map[string]interface{}{
@@ -157,11 +360,25 @@ func convertService(service Service, serviceName, projectName, framework string)
 		dockerService["image"] = service.Image
 	}
 
+	if service.PullPolicy != "" {
+		switch service.PullPolicy {
+		case "always", "missing", "never", "build":
+			dockerService["pull_policy"] = service.PullPolicy
+		default:
+			return nil, nil, fmt.Errorf("invalid pull_policy %q (expected always, missing, never or build)", service.PullPolicy)
+		}
+	}
+
 	// Add container name with project prefix
-	dockerService["container_name"] = fmt.Sprintf("%s-%s", projectName, serviceName)
+	dockerService["container_name"] = fmt.Sprintf("%s-%s", containerPrefix, serviceName)
 
-	// Add restart policy
-	if service.Restart != "" {
+	// Add restart policy (one-shot services run once and must not be restarted)
+	if service.Oneshot {
+		if service.Restart != "" && service.Restart != "no" {
+			warnings = append(warnings, fmt.Sprintf("restart '%s' ignored for oneshot service", service.Restart))
+		}
+		dockerService["restart"] = "no"
+	} else if service.Restart != "" {
 		dockerService["restart"] = service.Restart
 	} else {
 		dockerService["restart"] = "unless-stopped"
@@ -169,7 +386,14 @@ func convertService(service Service, serviceName, projectName, framework string)
 
 	// Add optional fields
 	if service.Command != nil {
-		dockerService["command"] = service.Command
+		command, warning, err := normalizeCommand(service.Command, service.CommandForm)
+		if err != nil {
+			return nil, nil, err
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+		dockerService["command"] = command
 	}
 	
 	if service.WorkingDir != "" {
@@ -193,10 +417,57 @@ func convertService(service Service, serviceName, projectName, framework string)
 	}
 
 	if len(service.Networks) > 0 {
-		dockerService["networks"] = service.Networks
+		dockerService["networks"] = service.Networks.toCompose()
+	}
+
+	// Stamp generated services so --merge can tell them from hand-added ones
+	labels := map[string]string{ManagedLabel: "true"}
+	for key, value := range service.Labels {
+		labels[key] = value
+	}
+	dockerService["labels"] = labels
+
+	if len(service.Ulimits) > 0 {
+		ulimits, err := normalizeUlimits(service.Ulimits)
+		if err != nil {
+			return nil, nil, err
+		}
+		dockerService["ulimits"] = ulimits
 	}
 
-	return dockerService
+	return dockerService, warnings, nil
+}
+
+// buildImageName returns the image name for a build service, applying an optional tag override
+func buildImageName(projectName, framework, serviceName, imageTag string) string {
+	imageName := fmt.Sprintf("%s-%s-%s", projectName, framework, serviceName)
+	switch {
+	case imageTag == "":
+		return imageName
+	case strings.ContainsAny(imageTag, ":/"):
+		return strings.ReplaceAll(imageTag, "{service}", serviceName)
+	default:
+		return imageName + ":" + imageTag
+	}
+}
+
+// validateImageTag rejects a full image reference that would give several build
+// services the same image
+func validateImageTag(imageTag string, services map[string]Service) error {
+	if imageTag == "" || !strings.ContainsAny(imageTag, ":/") || strings.Contains(imageTag, "{service}") {
+		return nil
+	}
+
+	buildServices := 0
+	for _, service := range services {
+		if service.Type == "build" {
+			buildServices++
+		}
+	}
+	if buildServices > 1 {
+		return fmt.Errorf("image tag '%s' would be shared by %d build services; include {service} in it (e.g. registry.example.com/app-{service}:1.0)", imageTag, buildServices)
+	}
+	return nil
 }
 
 // convertVolume converts a Atempo volume to Docker Compose volume
@@ -239,6 +510,10 @@ func convertNetwork(network Network) map[string]interface{} {
 		dockerNetwork["external"] = true
 	}
 
+	if network.IPAM != nil {
+		dockerNetwork["ipam"] = network.IPAM.toCompose()
+	}
+
 	return dockerNetwork
 }
 
@@ -257,18 +532,29 @@ func getServiceKey(service map[string]interface{}) string {
 
 // writeDockerCompose writes the Docker Compose structure to a YAML file
 func writeDockerCompose(compose *DockerCompose, filePath string) error {
-	data, err := yaml.Marshal(compose)
+	content, err := marshalDockerCompose(compose)
 	if err != nil {
-		return fmt.Errorf("failed to marshal docker-compose: %w", err)
+		return err
 	}
 
-	// Add header comment
-	header := "# Generated by Atempo from atempo.json\n# Do not edit this file directly - modify atempo.json and run 'atempo reconfigure'\n\n"
-	content := header + string(data)
-
 	return os.WriteFile(filePath, []byte(content), 0644)
 }
 
+// marshalDockerCompose renders the Docker Compose structure as YAML with the generated header
+func marshalDockerCompose(compose *DockerCompose) (string, error) {
+	// yaml.v3 writes map keys in sorted order, so services, volumes and networks
+	// (and their nested maps) come out the same on every run without extra sorting
+	data, err := yaml.Marshal(compose)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal docker-compose: %w", err)
+	}
+
+	// Add header comment; the checksum lets DetectManualEdits notice hand edits
+	header := fmt.Sprintf("%s (checksum %s)\n# Do not edit this file directly - modify atempo.json and run 'atempo reconfigure'\n\n",
+		generatedHeaderPrefix, composeChecksum(string(data)))
+	return header + string(data), nil
+}
+
 // AddService adds a new service to atempo.json
 func AddService(projectPath, serviceName string, service Service) error {
 	config, err := LoadAtempoConfig(projectPath)
@@ -309,6 +595,33 @@ func saveAtempoConfig(config *AtempoConfig, projectPath string) error {
 	return os.WriteFile(atempoJsonPath, data, 0644)
 }
 
+// SetProjectName updates the top-level "name" field in atempo.json, preserving all
+// other fields (including ones AtempoConfig doesn't model, such as the installer)
+// and their order
+func SetProjectName(projectPath, name string) error {
+	atempoJsonPath := filepath.Join(projectPath, "atempo.json")
+
+	data, err := os.ReadFile(atempoJsonPath)
+	if err != nil {
+		return fmt.Errorf("failed to read atempo.json: %w", err)
+	}
+
+	raw, err := ParseJSONObject(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse atempo.json: %w", err)
+	}
+	if err := raw.Set("name", name); err != nil {
+		return fmt.Errorf("failed to set name: %w", err)
+	}
+
+	data, err = MarshalConfigJSON(raw)
+	if err != nil {
+		return fmt.Errorf("failed to marshal atempo.json: %w", err)
+	}
+
+	return os.WriteFile(atempoJsonPath, data, 0644)
+}
+
 // AddPredefinedService adds a common service (minio, elasticsearch, etc.)
 func AddPredefinedService(projectPath, serviceType string) error {
 	service, exists := GetPredefinedService(serviceType)
@@ -319,12 +632,91 @@ func AddPredefinedService(projectPath, serviceType string) error {
 	return AddService(projectPath, serviceType, service)
 }
 
+// AddBuildService adds a custom "build" type service to atempo.json and scaffolds a
+// starter Dockerfile at the given path (relative to the project) if none exists yet.
+// It returns true when a starter Dockerfile was created.
+func AddBuildService(projectPath, serviceName, dockerfile, buildContext string, command interface{}) (bool, error) {
+	config, err := LoadAtempoConfig(projectPath)
+	if err != nil {
+		return false, err
+	}
+
+	if _, exists := config.Services[serviceName]; exists {
+		return false, fmt.Errorf("service '%s' already exists in atempo.json", serviceName)
+	}
+
+	if buildContext == "" {
+		buildContext = "."
+	}
+
+	service := Service{
+		Type:       "build",
+		Dockerfile: dockerfile,
+		Context:    buildContext,
+		Command:    command,
+	}
+	if config.Services == nil {
+		config.Services = make(map[string]Service)
+	}
+	config.Services[serviceName] = service
+
+	// Scaffold a starter Dockerfile if it doesn't exist
+	created := false
+	dockerfilePath := filepath.Join(projectPath, buildContext, dockerfile)
+	if _, err := os.Stat(dockerfilePath); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(dockerfilePath), 0755); err != nil {
+			return false, fmt.Errorf("failed to create Dockerfile directory: %w", err)
+		}
+		if err := os.WriteFile(dockerfilePath, []byte(starterDockerfile(serviceName, config.Language)), 0644); err != nil {
+			return false, fmt.Errorf("failed to write starter Dockerfile: %w", err)
+		}
+		created = true
+	}
+
+	if err := saveAtempoConfig(config, projectPath); err != nil {
+		// Don't leave a starter Dockerfile behind for a service that was never added
+		if created {
+			os.Remove(dockerfilePath)
+		}
+		return false, err
+	}
+
+	return created, nil
+}
+
+// starterDockerfile returns a minimal Dockerfile for a custom build service
+func starterDockerfile(serviceName, language string) string {
+	baseImage := "alpine:3.20"
+	workDir := "/app"
+	switch language {
+	case "php":
+		baseImage = "php:8.3-cli"
+		workDir = "/var/www"
+	case "python":
+		baseImage = "python:3.11-slim"
+	case "javascript", "typescript", "node":
+		baseImage = "node:20-alpine"
+	}
+
+	return fmt.Sprintf(`# Starter Dockerfile for the '%s' service generated by Atempo
+# Customize the base image, dependencies and command for your needs.
+FROM %s
+
+WORKDIR %s
+
+COPY . .
+
+CMD ["sh", "-c", "echo '%s service is running' && tail -f /dev/null"]
+`, serviceName, baseImage, workDir, serviceName)
+}
+
 // GetPredefinedService returns predefined service configurations
 func GetPredefinedService(serviceType string) (Service, bool) {
 	services := map[string]Service{
 		"minio": {
 			Type:  "image",
 			Image: "minio/minio",
+			PullPolicy: "missing",
 			Ports: []string{"9000:9000", "9001:9001"},
 			Command: []string{
 				"server", "/data", "--console-address", ":9001",
@@ -338,6 +730,7 @@ func GetPredefinedService(serviceType string) (Service, bool) {
 		"elasticsearch": {
 			Type:  "image",
 			Image: "elasticsearch:8.8.0",
+			PullPolicy: "missing",
 			Ports: []string{"9200:9200"},
 			Environment: map[string]string{
 				"discovery.type":         "single-node",
@@ -345,10 +738,16 @@ func GetPredefinedService(serviceType string) (Service, bool) {
 				"ES_JAVA_OPTS":          "-Xms512m -Xmx512m",
 			},
 			Volumes: []string{"elasticsearch_data:/usr/share/elasticsearch/data"},
+			// Elasticsearch needs unlimited memlock and a high open file limit
+			Ulimits: map[string]interface{}{
+				"memlock": map[string]int{"soft": -1, "hard": -1},
+				"nofile":  map[string]int{"soft": 65536, "hard": 65536},
+			},
 		},
 		"rabbitmq": {
 			Type:  "image",
 			Image: "rabbitmq:3-management",
+			PullPolicy: "missing",
 			Ports: []string{"5672:5672", "15672:15672"},
 			Environment: map[string]string{
 				"RABBITMQ_DEFAULT_USER": "admin",
@@ -359,6 +758,7 @@ func GetPredefinedService(serviceType string) (Service, bool) {
 		"mongodb": {
 			Type:  "image",
 			Image: "mongo:6",
+			PullPolicy: "missing",
 			Ports: []string{"27017:27017"},
 			Environment: map[string]string{
 				"MONGO_INITDB_ROOT_USERNAME": "admin",
@@ -368,11 +768,27 @@ func GetPredefinedService(serviceType string) (Service, bool) {
 		},
 	}
 
+	services["traefik"] = traefikService()
+
 	service, exists := services[serviceType]
 	return service, exists
 }
 
 // ListPredefinedServices returns available predefined services
 func ListPredefinedServices() []string {
-	return []string{"minio", "elasticsearch", "rabbitmq", "mongodb"}
+	return []string{"minio", "elasticsearch", "rabbitmq", "mongodb", "traefik"}
+}
+
+// predefinedServiceDescriptions holds a one-line summary of each predefined service
+var predefinedServiceDescriptions = map[string]string{
+	"minio":         "S3-compatible object storage with a web console",
+	"elasticsearch": "Full-text search and analytics engine (single node)",
+	"rabbitmq":      "Message broker with the management UI",
+	"mongodb":       "Document database",
+	"traefik":       "Reverse proxy routing <service>.<project>.localhost to containers",
+}
+
+// PredefinedServiceDescription returns a one-line summary of a predefined service
+func PredefinedServiceDescription(serviceType string) string {
+	return predefinedServiceDescriptions[serviceType]
 }
\ No newline at end of file
@@ -0,0 +1,35 @@
+package compose
+
+import "testing"
+
+func TestRenderDockerComposeIsDeterministic(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "atempo.json", `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {
+    "app": {"type": "image", "image": "php:8.3-fpm", "depends_on": ["mysql", "redis", "migrate"],
+      "environment": {"Z_LAST": "1", "A_FIRST": "2", "M_MIDDLE": "3"}, "networks": ["frontend", "backend"]},
+    "migrate": {"type": "image", "image": "php:8.3-fpm", "oneshot": true},
+    "mysql": {"type": "image", "image": "mysql:8", "volumes": ["mysql_data:/var/lib/mysql"], "networks": ["backend"]},
+    "redis": {"type": "image", "image": "redis:7", "labels": {"b": "2", "a": "1"}, "networks": ["backend"]},
+    "worker": {"type": "image", "image": "php:8.3-fpm", "networks": {"backend": {"aliases": ["jobs", "queue"]}}}
+  },
+  "volumes": {"mysql_data": {}, "cache": {}},
+  "networks": {"frontend": {"driver": "bridge"}, "backend": {"driver": "bridge"}}
+}`)
+
+	first, _, err := RenderDockerCompose(dir, GenerateOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	for run := 1; run < 25; run++ {
+		content, _, err := RenderDockerCompose(dir, GenerateOptions{})
+		if err != nil {
+			t.Fatalf("render %d: %v", run, err)
+		}
+		if content != first {
+			t.Fatalf("render %d differs from the first render:\n%s\n---\n%s", run, first, content)
+		}
+	}
+}
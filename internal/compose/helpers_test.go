@@ -0,0 +1,75 @@
+package compose
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// writeFile writes a file into dir, failing the test on error
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readCompose parses the docker-compose.yml in dir
+func readCompose(t *testing.T, dir string) DockerCompose {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(dir, "docker-compose.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var compose DockerCompose
+	if err := yaml.Unmarshal(data, &compose); err != nil {
+		t.Fatal(err)
+	}
+	return compose
+}
+
+// renderConfig renders atempoJSON in a fresh project directory and parses the result
+func renderConfig(t *testing.T, atempoJSON string) (map[string]interface{}, error) {
+	t.Helper()
+	dir := t.TempDir()
+	writeFile(t, dir, "atempo.json", atempoJSON)
+
+	content, _, err := RenderDockerCompose(dir, GenerateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var rendered map[string]interface{}
+	if err := yaml.Unmarshal([]byte(content), &rendered); err != nil {
+		t.Fatalf("rendered docker-compose.yml does not parse: %v", err)
+	}
+	return rendered, nil
+}
+
+// lookup follows a path of map keys through parsed YAML, failing the test when one is missing
+func lookup(t *testing.T, value interface{}, keys ...string) interface{} {
+	t.Helper()
+	for _, key := range keys {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			t.Fatalf("expected a map at %q, got %T", key, value)
+		}
+		if value, ok = m[key]; !ok {
+			t.Fatalf("missing key %q", key)
+		}
+	}
+	return value
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
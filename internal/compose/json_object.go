@@ -0,0 +1,131 @@
+package compose
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONObject is a JSON object that keeps its keys in document order, so a single
+// field of a user's atempo.json can be changed without reordering the rest of it
+type JSONObject struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+// NewJSONObject returns an empty object
+func NewJSONObject() *JSONObject {
+	return &JSONObject{values: make(map[string]json.RawMessage)}
+}
+
+// ParseJSONObject decodes a JSON object, remembering the order of its keys
+func ParseJSONObject(data []byte) (*JSONObject, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+
+	object := NewJSONObject()
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := token.(string) // Object keys are always strings
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		if _, exists := object.values[key]; !exists {
+			object.keys = append(object.keys, key)
+		}
+		object.values[key] = value
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+// Keys returns the object's keys in document order
+func (o *JSONObject) Keys() []string {
+	return o.keys
+}
+
+// Get returns the raw value stored under key
+func (o *JSONObject) Get(key string) (json.RawMessage, bool) {
+	value, ok := o.values[key]
+	return value, ok
+}
+
+// Object returns the nested object stored under key, or nil when the key is unset
+func (o *JSONObject) Object(key string) (*JSONObject, error) {
+	value, ok := o.values[key]
+	if !ok || string(value) == "null" {
+		return nil, nil
+	}
+	object, err := ParseJSONObject(value)
+	if err != nil {
+		return nil, fmt.Errorf("'%s': %w", key, err)
+	}
+	return object, nil
+}
+
+// Set stores value under key. An existing key keeps its position; a new key is
+// appended at the end.
+func (o *JSONObject) Set(key string, value interface{}) error {
+	data, err := marshalJSON(value, "")
+	if err != nil {
+		return err
+	}
+	if _, exists := o.values[key]; !exists {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = data
+	return nil
+}
+
+// MarshalJSON encodes the object with its keys in order
+func (o *JSONObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		encodedKey, err := marshalJSON(key, "")
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+		buf.Write(o.values[key])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// MarshalConfigJSON encodes an atempo.json document indented by two spaces, leaving
+// characters such as <, > and & unescaped so hand-written commands stay readable
+func MarshalConfigJSON(value interface{}) ([]byte, error) {
+	return marshalJSON(value, "  ")
+}
+
+// marshalJSON encodes value without HTML escaping, indented when indent is set
+func marshalJSON(value interface{}, indent string) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if indent != "" {
+		encoder.SetIndent("", indent)
+	}
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
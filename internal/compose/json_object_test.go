@@ -0,0 +1,71 @@
+package compose
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetProjectNameKeepsKeyOrder(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "atempo.json", `{
+  "name": "{{project}}",
+  "framework": "laravel",
+  "services": {
+    "worker": {"type": "image", "image": "php:8.3-fpm", "command": "php artisan queue:work && echo <done>"},
+    "app": {"type": "image", "image": "php:8.3-fpm"}
+  },
+  "installer": {"type": "docker"}
+}`)
+
+	if err := SetProjectName(dir, "shop"); err != nil {
+		t.Fatalf("SetProjectName: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "atempo.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {
+    "worker": {
+      "type": "image",
+      "image": "php:8.3-fpm",
+      "command": "php artisan queue:work && echo <done>"
+    },
+    "app": {
+      "type": "image",
+      "image": "php:8.3-fpm"
+    }
+  },
+  "installer": {
+    "type": "docker"
+  }
+}`
+	if string(data) != want {
+		t.Errorf("atempo.json =\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestJSONObjectSetAppendsNewKeys(t *testing.T) {
+	object, err := ParseJSONObject([]byte(`{"b": 1, "a": 2}`))
+	if err != nil {
+		t.Fatalf("ParseJSONObject: %v", err)
+	}
+	if err := object.Set("a", 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := object.Set("c", "x"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := object.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"b":1,"a":3,"c":"x"}`; string(data) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", data, want)
+	}
+}
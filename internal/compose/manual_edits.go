@@ -0,0 +1,53 @@
+package compose
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// generatedHeaderPrefix starts the first line of every generated docker-compose.yml
+const generatedHeaderPrefix = "# Generated by Atempo from atempo.json"
+
+var checksumPattern = regexp.MustCompile(`^# Generated by Atempo from atempo\.json \(checksum ([0-9a-f]+)\)$`)
+
+// composeChecksum returns the short checksum stamped into the header for a YAML body
+func composeChecksum(body string) string {
+	sum := sha256.Sum256([]byte(body))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// DetectManualEdits reports why docker-compose.yml looks hand-edited, or "" when it
+// is missing or unchanged since generation. Files generated before checksums were
+// stamped cannot be checked and are treated as unchanged.
+func DetectManualEdits(projectPath string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, "docker-compose.yml"))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read docker-compose.yml: %w", err)
+	}
+
+	content := string(data)
+	firstLine, _, _ := strings.Cut(content, "\n")
+	if !strings.HasPrefix(firstLine, generatedHeaderPrefix) {
+		return "docker-compose.yml has no Atempo header, so it was written or replaced by hand", nil
+	}
+
+	match := checksumPattern.FindStringSubmatch(firstLine)
+	if match == nil {
+		return "", nil
+	}
+
+	// The body starts after the blank line that ends the header
+	_, body, found := strings.Cut(content, "\n\n")
+	if !found || composeChecksum(body) != match[1] {
+		return "docker-compose.yml was edited by hand since it was last generated", nil
+	}
+	return "", nil
+}
@@ -0,0 +1,78 @@
+package compose
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManagedLabel marks services generated from atempo.json. A labelled service that
+// atempo.json no longer defines was deleted there, not added by hand.
+const ManagedLabel = "atempo.managed"
+
+// mergeManualAdditions copies services, volumes and networks that exist in the
+// current docker-compose.yml but are not generated from atempo.json into compose,
+// so sidecars added by hand survive regeneration. Entries atempo.json defines are
+// always regenerated, and services carrying ManagedLabel are dropped. It returns a
+// warning naming each preserved entry.
+func mergeManualAdditions(projectPath string, compose *DockerCompose) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, "docker-compose.yml"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read docker-compose.yml: %w", err)
+	}
+
+	var existing DockerCompose
+	if err := yaml.Unmarshal(data, &existing); err != nil {
+		return nil, fmt.Errorf("failed to parse existing docker-compose.yml for --merge: %w", err)
+	}
+
+	var warnings []string
+	warnings = append(warnings, mergeSection("service", existing.Services, compose.Services)...)
+	warnings = append(warnings, mergeSection("volume", existing.Volumes, compose.Volumes)...)
+	warnings = append(warnings, mergeSection("network", existing.Networks, compose.Networks)...)
+	return warnings, nil
+}
+
+// mergeSection adds entries of existing that generated lacks, in sorted order
+func mergeSection(kind string, existing, generated map[string]interface{}) []string {
+	names := make([]string, 0, len(existing))
+	for name := range existing {
+		if _, defined := generated[name]; !defined && !isManaged(existing[name]) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var warnings []string
+	for _, name := range names {
+		generated[name] = existing[name]
+		warnings = append(warnings, fmt.Sprintf("kept %s '%s' from docker-compose.yml (not defined in atempo.json)", kind, name))
+	}
+	return warnings
+}
+
+// isManaged reports whether a docker-compose.yml entry carries ManagedLabel, as a
+// map or as a list of key=value labels
+func isManaged(entry interface{}) bool {
+	definition, ok := entry.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	switch labels := definition["labels"].(type) {
+	case map[string]interface{}:
+		return fmt.Sprint(labels[ManagedLabel]) == "true"
+	case []interface{}:
+		for _, label := range labels {
+			if fmt.Sprint(label) == ManagedLabel+"=true" {
+				return true
+			}
+		}
+	}
+	return false
+}
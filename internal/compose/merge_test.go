@@ -0,0 +1,50 @@
+package compose
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestMergeDropsServicesDeletedFromAtempoJSON(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "atempo.json", `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {
+    "app": {"type": "image", "image": "php:8.3-fpm"},
+    "redis": {"type": "image", "image": "redis:7"}
+  }
+}`)
+	if err := GenerateDockerComposeWithOptions(dir, GenerateOptions{}); err != nil {
+		t.Fatalf("initial generate: %v", err)
+	}
+
+	// Add a sidecar by hand, then delete redis from atempo.json
+	compose := readCompose(t, dir)
+	compose.Services["mailpit"] = map[string]interface{}{"image": "axllent/mailpit"}
+	data, err := yaml.Marshal(compose)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, dir, "docker-compose.yml", string(data))
+	writeFile(t, dir, "atempo.json", `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {
+    "app": {"type": "image", "image": "php:8.3-fpm"}
+  }
+}`)
+
+	if err := GenerateDockerComposeWithOptions(dir, GenerateOptions{Merge: true}); err != nil {
+		t.Fatalf("merge generate: %v", err)
+	}
+
+	services := readCompose(t, dir).Services
+	if _, ok := services["mailpit"]; !ok {
+		t.Error("hand-added service 'mailpit' was not kept")
+	}
+	if _, ok := services["redis"]; ok {
+		t.Error("service 'redis' deleted from atempo.json was brought back")
+	}
+}
@@ -0,0 +1,166 @@
+package compose
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+)
+
+// NetworkIPAM pins a network to a fixed address range so container IPs are
+// deterministic. Gateway and IPRange are optional and must lie within Subnet.
+type NetworkIPAM struct {
+	Driver  string `json:"driver,omitempty"`
+	Subnet  string `json:"subnet"`
+	Gateway string `json:"gateway,omitempty"`
+	IPRange string `json:"ip_range,omitempty"`
+}
+
+// validateNetworkIPAM checks that a network's subnet, gateway and range are consistent
+func validateNetworkIPAM(network Network) error {
+	ipam := network.IPAM
+	if ipam == nil {
+		return nil
+	}
+	if network.External {
+		return fmt.Errorf("ipam cannot be set on an external network")
+	}
+
+	_, subnet, err := net.ParseCIDR(ipam.Subnet)
+	if err != nil {
+		return fmt.Errorf("ipam subnet '%s' is not a CIDR range (e.g. 172.28.0.0/16)", ipam.Subnet)
+	}
+
+	if ipam.Gateway != "" {
+		gateway := net.ParseIP(ipam.Gateway)
+		if gateway == nil || !subnet.Contains(gateway) {
+			return fmt.Errorf("ipam gateway '%s' is not an address within %s", ipam.Gateway, ipam.Subnet)
+		}
+	}
+
+	if ipam.IPRange != "" {
+		rangeIP, ipRange, err := net.ParseCIDR(ipam.IPRange)
+		if err != nil || !subnet.Contains(rangeIP) || !subnet.Contains(lastAddress(ipRange)) {
+			return fmt.Errorf("ipam ip_range '%s' is not a CIDR range within %s", ipam.IPRange, ipam.Subnet)
+		}
+	}
+	return nil
+}
+
+// lastAddress returns the highest address of a CIDR range
+func lastAddress(ipNet *net.IPNet) net.IP {
+	last := make(net.IP, len(ipNet.IP))
+	for i := range ipNet.IP {
+		last[i] = ipNet.IP[i] | ^ipNet.Mask[i]
+	}
+	return last
+}
+
+// toCompose converts the IPAM settings to docker-compose's ipam block
+func (i NetworkIPAM) toCompose() map[string]interface{} {
+	entry := map[string]interface{}{"subnet": i.Subnet}
+	if i.Gateway != "" {
+		entry["gateway"] = i.Gateway
+	}
+	if i.IPRange != "" {
+		entry["ip_range"] = i.IPRange
+	}
+
+	ipam := map[string]interface{}{"config": []interface{}{entry}}
+	if i.Driver != "" {
+		ipam["driver"] = i.Driver
+	}
+	return ipam
+}
+
+// ServiceNetwork holds per-network attachment options for a service
+type ServiceNetwork struct {
+	Aliases     []string `json:"aliases,omitempty"`
+	IPv4Address string   `json:"ipv4_address,omitempty"`
+}
+
+// ServiceNetworks maps network names to attachment options. In atempo.json it
+// accepts both the short list form (["backend"]) and the long map form
+// ({"backend": {"aliases": ["db"]}}), mirroring docker-compose.
+type ServiceNetworks map[string]ServiceNetwork
+
+// UnmarshalJSON accepts either a list of network names or a map of network options
+func (n *ServiceNetworks) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err == nil {
+		networks := make(ServiceNetworks, len(names))
+		for _, name := range names {
+			networks[name] = ServiceNetwork{}
+		}
+		*n = networks
+		return nil
+	}
+
+	var long map[string]*ServiceNetwork
+	if err := json.Unmarshal(data, &long); err != nil {
+		return fmt.Errorf("networks must be a list of names or a map of network options: %w", err)
+	}
+
+	networks := make(ServiceNetworks, len(long))
+	for name, options := range long {
+		if options == nil {
+			networks[name] = ServiceNetwork{}
+		} else {
+			networks[name] = *options
+		}
+	}
+	*n = networks
+	return nil
+}
+
+// MarshalJSON writes the short list form unless any network has options
+func (n ServiceNetworks) MarshalJSON() ([]byte, error) {
+	if !n.hasOptions() {
+		return json.Marshal(n.Names())
+	}
+	return json.Marshal(map[string]ServiceNetwork(n))
+}
+
+// Names returns the attached network names in sorted order
+func (n ServiceNetworks) Names() []string {
+	names := make([]string, 0, len(n))
+	for name := range n {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// hasOptions reports whether any attachment uses long-form options
+func (n ServiceNetworks) hasOptions() bool {
+	for _, options := range n {
+		if len(options.Aliases) > 0 || options.IPv4Address != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// toCompose converts the attachments to their docker-compose representation
+func (n ServiceNetworks) toCompose() interface{} {
+	if !n.hasOptions() {
+		return n.Names()
+	}
+
+	networks := make(map[string]interface{}, len(n))
+	for name, options := range n {
+		entry := make(map[string]interface{})
+		if len(options.Aliases) > 0 {
+			entry["aliases"] = options.Aliases
+		}
+		if options.IPv4Address != "" {
+			entry["ipv4_address"] = options.IPv4Address
+		}
+		if len(entry) == 0 {
+			networks[name] = map[string]interface{}{}
+		} else {
+			networks[name] = entry
+		}
+	}
+	return networks
+}
@@ -0,0 +1,156 @@
+package compose
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNetworkIPAMRendersConfig(t *testing.T) {
+	rendered, err := renderConfig(t, `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {
+    "app": {"type": "image", "image": "php:8.3-fpm", "networks": {"backend": {"ipv4_address": "172.28.5.10"}}}
+  },
+  "networks": {
+    "backend": {
+      "driver": "bridge",
+      "ipam": {"driver": "default", "subnet": "172.28.0.0/16", "gateway": "172.28.0.1", "ip_range": "172.28.5.0/24"}
+    }
+  }
+}`)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	ipam := lookup(t, rendered, "networks", "backend", "ipam")
+	want := map[string]interface{}{
+		"driver": "default",
+		"config": []interface{}{
+			map[string]interface{}{"subnet": "172.28.0.0/16", "gateway": "172.28.0.1", "ip_range": "172.28.5.0/24"},
+		},
+	}
+	if !reflect.DeepEqual(ipam, want) {
+		t.Errorf("ipam = %#v, want %#v", ipam, want)
+	}
+}
+
+func TestValidateNetworkIPAM(t *testing.T) {
+	tests := []struct {
+		name    string
+		network Network
+		wantErr string // Substring of the expected error; "" means valid
+	}{
+		{
+			name:    "valid",
+			network: Network{IPAM: &NetworkIPAM{Subnet: "10.5.0.0/24", Gateway: "10.5.0.1", IPRange: "10.5.0.128/25"}},
+		},
+		{
+			name:    "gateway outside subnet",
+			network: Network{IPAM: &NetworkIPAM{Subnet: "10.5.0.0/24", Gateway: "10.6.0.1"}},
+			wantErr: "ipam gateway '10.6.0.1'",
+		},
+		{
+			name:    "ip_range outside subnet",
+			network: Network{IPAM: &NetworkIPAM{Subnet: "10.5.0.0/24", IPRange: "10.5.1.0/24"}},
+			wantErr: "ipam ip_range '10.5.1.0/24'",
+		},
+		{
+			name:    "ip_range larger than subnet",
+			network: Network{IPAM: &NetworkIPAM{Subnet: "10.5.0.0/24", IPRange: "10.5.0.0/16"}},
+			wantErr: "ipam ip_range '10.5.0.0/16'",
+		},
+		{
+			name:    "subnet not a CIDR",
+			network: Network{IPAM: &NetworkIPAM{Subnet: "10.5.0.0"}},
+			wantErr: "not a CIDR range",
+		},
+		{
+			name:    "external network",
+			network: Network{External: true, IPAM: &NetworkIPAM{Subnet: "10.5.0.0/24"}},
+			wantErr: "external network",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNetworkIPAM(tt.network)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("expected an error containing %q", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("error %q does not contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNetworkIPAMOnExternalNetworkFailsRender(t *testing.T) {
+	_, err := renderConfig(t, `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {"app": {"type": "image", "image": "php:8.3-fpm", "networks": ["shared"]}},
+  "networks": {"shared": {"external": true, "ipam": {"subnet": "172.28.0.0/16"}}}
+}`)
+	if err == nil || !strings.Contains(err.Error(), "external network") {
+		t.Fatalf("expected an external network error, got %v", err)
+	}
+}
+
+func TestServiceNetworksRender(t *testing.T) {
+	rendered, err := renderConfig(t, `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {
+    "app": {"type": "image", "image": "php:8.3-fpm", "networks": ["frontend", "backend"]},
+    "mysql": {"type": "image", "image": "mysql:8", "networks": {"backend": {"aliases": ["db", "database"]}, "frontend": null}}
+  },
+  "networks": {"frontend": {"driver": "bridge"}, "backend": {"driver": "bridge"}}
+}`)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	short := lookup(t, rendered, "services", "app", "networks")
+	if want := []interface{}{"backend", "frontend"}; !reflect.DeepEqual(short, want) {
+		t.Errorf("app networks = %#v, want %#v", short, want)
+	}
+
+	long := lookup(t, rendered, "services", "mysql", "networks")
+	want := map[string]interface{}{
+		"backend":  map[string]interface{}{"aliases": []interface{}{"db", "database"}},
+		"frontend": map[string]interface{}{},
+	}
+	if !reflect.DeepEqual(long, want) {
+		t.Errorf("mysql networks = %#v, want %#v", long, want)
+	}
+}
+
+func TestServiceNetworksUndeclaredNetworkFails(t *testing.T) {
+	_, err := renderConfig(t, `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {"app": {"type": "image", "image": "php:8.3-fpm", "networks": {"backend": {"aliases": ["api"]}}}}
+}`)
+	if err == nil || !strings.Contains(err.Error(), "undeclared network 'backend'") {
+		t.Fatalf("expected an undeclared network error, got %v", err)
+	}
+}
+
+func TestServiceNetworksDefaultNetwork(t *testing.T) {
+	rendered, err := renderConfig(t, `{
+  "name": "shop",
+  "framework": "laravel",
+  "services": {"app": {"type": "image", "image": "php:8.3-fpm"}}
+}`)
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	if got := lookup(t, rendered, "services", "app", "networks"); !reflect.DeepEqual(got, []interface{}{"laravel"}) {
+		t.Errorf("app networks = %#v, want [laravel]", got)
+	}
+	lookup(t, rendered, "networks", "laravel")
+}
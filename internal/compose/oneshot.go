@@ -0,0 +1,41 @@
+package compose
+
+import "fmt"
+
+// applyOneshotDependencies rewrites depends_on in long form for services that depend
+// on a one-shot service, so compose waits for it to exit successfully rather than
+// merely start. Other dependencies keep their default "service_started" condition.
+func applyOneshotDependencies(services map[string]Service, composeServices map[string]interface{}) error {
+	for serviceName, service := range services {
+		if !dependsOnOneshot(services, service.DependsOn) {
+			continue
+		}
+
+		dependencies := make(map[string]interface{}, len(service.DependsOn))
+		for _, dependency := range service.DependsOn {
+			target, ok := services[dependency]
+			if !ok {
+				return fmt.Errorf("service '%s' depends on undefined service '%s'", serviceName, dependency)
+			}
+
+			condition := "service_started"
+			if target.Oneshot {
+				condition = "service_completed_successfully"
+			}
+			dependencies[dependency] = map[string]string{"condition": condition}
+		}
+
+		composeServices[serviceName].(map[string]interface{})["depends_on"] = dependencies
+	}
+	return nil
+}
+
+// dependsOnOneshot reports whether any of the named dependencies is a one-shot service
+func dependsOnOneshot(services map[string]Service, dependsOn []string) bool {
+	for _, dependency := range dependsOn {
+		if services[dependency].Oneshot {
+			return true
+		}
+	}
+	return false
+}
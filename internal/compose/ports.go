@@ -0,0 +1,63 @@
+package compose
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PortMapping represents a parsed compose port specification
+type PortMapping struct {
+	HostIP        string
+	HostPort      int
+	ContainerPort int
+	Protocol      string
+}
+
+// ParsePortMapping parses a compose short-syntax port such as "8000:80",
+// "127.0.0.1:8000:80" or "5432:5432/tcp". Mappings without a fixed host port
+// (e.g. "80" or port ranges) are reported as not ok.
+func ParsePortMapping(spec string) (PortMapping, bool) {
+	mapping := PortMapping{Protocol: "tcp"}
+
+	if idx := strings.LastIndex(spec, "/"); idx != -1 {
+		mapping.Protocol = spec[idx+1:]
+		spec = spec[:idx]
+	}
+
+	parts := strings.Split(spec, ":")
+	var hostPart, containerPart string
+	switch len(parts) {
+	case 2:
+		hostPart, containerPart = parts[0], parts[1]
+	case 3:
+		mapping.HostIP = parts[0]
+		hostPart, containerPart = parts[1], parts[2]
+	default:
+		return mapping, false
+	}
+
+	hostPort, err := strconv.Atoi(hostPart)
+	if err != nil {
+		return mapping, false
+	}
+	containerPort, err := strconv.Atoi(containerPart)
+	if err != nil {
+		return mapping, false
+	}
+
+	mapping.HostPort = hostPort
+	mapping.ContainerPort = containerPort
+	return mapping, true
+}
+
+// String formats the mapping in compose short syntax
+func (m PortMapping) String() string {
+	spec := strconv.Itoa(m.HostPort) + ":" + strconv.Itoa(m.ContainerPort)
+	if m.HostIP != "" {
+		spec = m.HostIP + ":" + spec
+	}
+	if m.Protocol != "" && m.Protocol != "tcp" {
+		spec += "/" + m.Protocol
+	}
+	return spec
+}
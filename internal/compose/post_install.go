@@ -0,0 +1,90 @@
+package compose
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// PostInstallHook is a command run in a service container after scaffolding.
+// Command is either a string (run with "sh -c") or a list of arguments.
+type PostInstallHook struct {
+	Container string      `json:"container"`
+	Command   interface{} `json:"command"`
+
+	// Note holds a plain string entry. Older templates listed the setup steps as
+	// descriptions, which projects created from them still carry; notes never run.
+	Note string `json:"-"`
+}
+
+// UnmarshalJSON accepts a hook object or a legacy description string
+func (h *PostInstallHook) UnmarshalJSON(data []byte) error {
+	var note string
+	if err := json.Unmarshal(data, &note); err == nil {
+		*h = PostInstallHook{Note: note}
+		return nil
+	}
+
+	type hook PostInstallHook // Without the method, to avoid recursion
+	return json.Unmarshal(data, (*hook)(h))
+}
+
+// PostInstallHooks returns the post_install hooks to run, leaving out legacy notes
+func (c *AtempoConfig) PostInstallHooks() []PostInstallHook {
+	var hooks []PostInstallHook
+	for _, hook := range c.PostInstall {
+		if hook.Note == "" {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks
+}
+
+// Args returns the hook's command as exec arguments
+func (h PostInstallHook) Args() ([]string, error) {
+	if h.Container == "" {
+		return nil, fmt.Errorf("post_install hook is missing \"container\"")
+	}
+
+	switch command := h.Command.(type) {
+	case string:
+		if strings.TrimSpace(command) == "" {
+			break
+		}
+		return []string{"sh", "-c", command}, nil
+	case []interface{}:
+		args := make([]string, 0, len(command))
+		for _, arg := range command {
+			value, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("post_install hook for '%s': command arguments must be strings", h.Container)
+			}
+			args = append(args, value)
+		}
+		if len(args) > 0 {
+			return args, nil
+		}
+	case []string:
+		if len(command) > 0 {
+			return command, nil
+		}
+	}
+
+	return nil, fmt.Errorf("post_install hook for '%s' is missing \"command\"", h.Container)
+}
+
+// validatePostInstallHooks checks that every hook has a command and targets a declared service
+func validatePostInstallHooks(config *AtempoConfig) error {
+	for i, hook := range config.PostInstall {
+		if hook.Note != "" {
+			continue
+		}
+		if _, err := hook.Args(); err != nil {
+			return fmt.Errorf("post_install[%d]: %w", i, err)
+		}
+		if _, ok := config.Services[hook.Container]; !ok {
+			return fmt.Errorf("post_install[%d]: container '%s' is not a service in atempo.json", i, hook.Container)
+		}
+	}
+	return nil
+}
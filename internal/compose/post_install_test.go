@@ -0,0 +1,59 @@
+package compose
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadAtempoConfigShippedTemplates(t *testing.T) {
+	for _, framework := range []string{"laravel", "django"} {
+		t.Run(framework, func(t *testing.T) {
+			config, err := LoadAtempoConfig(filepath.Join("..", "..", "templates", "frameworks", framework))
+			if err != nil {
+				t.Fatalf("LoadAtempoConfig: %v", err)
+			}
+			if config.Framework != framework {
+				t.Errorf("framework = %q, want %q", config.Framework, framework)
+			}
+			if err := validatePostInstallHooks(config); err != nil {
+				t.Errorf("validatePostInstallHooks: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoadAtempoConfigLegacyPostInstallNotes(t *testing.T) {
+	dir := t.TempDir()
+	content := `{
+  "framework": "laravel",
+  "services": {"app": {"type": "image", "image": "php:8.3-fpm"}},
+  "post_install": [
+    "Generate Laravel application key",
+    {"container": "app", "command": "php artisan key:generate"}
+  ]
+}`
+	if err := os.WriteFile(filepath.Join(dir, "atempo.json"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadAtempoConfig(dir)
+	if err != nil {
+		t.Fatalf("LoadAtempoConfig: %v", err)
+	}
+	if err := validatePostInstallHooks(config); err != nil {
+		t.Fatalf("validatePostInstallHooks: %v", err)
+	}
+
+	hooks := config.PostInstallHooks()
+	if len(hooks) != 1 {
+		t.Fatalf("PostInstallHooks() returned %d hooks, want 1 (notes are skipped)", len(hooks))
+	}
+	args, err := hooks[0].Args()
+	if err != nil {
+		t.Fatalf("Args: %v", err)
+	}
+	if want := []string{"sh", "-c", "php artisan key:generate"}; !equalStrings(args, want) {
+		t.Errorf("Args() = %q, want %q", args, want)
+	}
+}
@@ -0,0 +1,88 @@
+package compose
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Policies for host ports below 1024, set with "privileged_ports" in atempo.json
+const (
+	PrivilegedPortsWarn  = "warn"  // Keep the port and warn (default)
+	PrivilegedPortsRemap = "remap" // Move the host port above 1024 (80 -> 8080, 443 -> 8443)
+	PrivilegedPortsAllow = "allow" // Keep the port silently (e.g. rootful Docker)
+)
+
+// privilegedPortOffset is added to privileged host ports when remapping
+const privilegedPortOffset = 8000
+
+// applyPrivilegedPortPolicy checks service host ports below 1024, which can't be
+// bound without root on Linux, and warns about or remaps them according to the
+// config's policy. Remapped ports are written back into config.
+func applyPrivilegedPortPolicy(config *AtempoConfig) ([]string, error) {
+	policy := config.PrivilegedPorts
+	switch policy {
+	case "":
+		policy = PrivilegedPortsWarn
+	case PrivilegedPortsWarn, PrivilegedPortsRemap, PrivilegedPortsAllow:
+	default:
+		return nil, fmt.Errorf("invalid privileged_ports %q (expected %q, %q or %q)", policy, PrivilegedPortsWarn, PrivilegedPortsRemap, PrivilegedPortsAllow)
+	}
+	if policy == PrivilegedPortsAllow {
+		return nil, nil
+	}
+
+	// Track every configured host port so remapped ports don't collide
+	usedPorts := make(map[int]bool)
+	for _, service := range config.Services {
+		for _, spec := range service.Ports {
+			if mapping, ok := ParsePortMapping(spec); ok {
+				usedPorts[mapping.HostPort] = true
+			}
+		}
+	}
+
+	var warnings []string
+	for _, serviceName := range sortedServiceNames(config.Services) {
+		service := config.Services[serviceName]
+		changed := false
+
+		for i, spec := range service.Ports {
+			mapping, ok := ParsePortMapping(spec)
+			if !ok || mapping.HostPort == 0 || mapping.HostPort >= 1024 {
+				continue
+			}
+
+			if policy == PrivilegedPortsWarn {
+				warnings = append(warnings, fmt.Sprintf("service '%s': host port %d is privileged and may fail to bind without root on Linux (set \"privileged_ports\": \"remap\" in atempo.json to use a high port)", serviceName, mapping.HostPort))
+				continue
+			}
+
+			newPort := mapping.HostPort + privilegedPortOffset
+			for usedPorts[newPort] {
+				newPort++
+			}
+			usedPorts[newPort] = true
+
+			warnings = append(warnings, fmt.Sprintf("service '%s': privileged host port %d remapped to %d", serviceName, mapping.HostPort, newPort))
+			mapping.HostPort = newPort
+			service.Ports[i] = mapping.String()
+			changed = true
+		}
+
+		if changed {
+			config.Services[serviceName] = service
+		}
+	}
+
+	return warnings, nil
+}
+
+// sortedServiceNames returns service names in a stable order
+func sortedServiceNames(services map[string]Service) []string {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,218 @@
+package compose
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// scaffoldKeys are top-level atempo.json keys read by the scaffolder when a project
+// is created rather than by the generator; their contents are not checked here
+var scaffoldKeys = map[string]bool{"installer": true, "min-version": true, "max-version": true}
+
+// UnknownField is an atempo.json key that no setting reads, usually a typo
+type UnknownField struct {
+	Key        string // The unknown key
+	Path       string // Dotted location of the enclosing object, "" at the top level
+	Line       int
+	Suggestion string // Closest known key, if one is similar
+}
+
+// String describes the field with its line, e.g. for validate output
+func (f UnknownField) String() string {
+	location := "at the top level"
+	if f.Path != "" {
+		location = "in " + f.Path
+	}
+	message := fmt.Sprintf("line %d: unknown key \"%s\" %s", f.Line, f.Key, location)
+	if f.Suggestion != "" {
+		message += fmt.Sprintf(" (did you mean \"%s\"?)", f.Suggestion)
+	}
+	return message
+}
+
+// ErrUnknownFields is returned in strict mode when atempo.json has unknown keys
+var ErrUnknownFields = errors.New("atempo.json has unknown keys")
+
+// FindUnknownFields reports every key in the project's atempo.json that does not
+// correspond to a setting, in file order. Plain json.Unmarshal ignores such keys,
+// so a typo like "prots" would otherwise be dropped silently.
+func FindUnknownFields(projectPath string) ([]UnknownField, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, "atempo.json"))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w in %s", ErrConfigNotFound, projectPath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read atempo.json: %w", err)
+	}
+
+	checker := &fieldChecker{data: data, decoder: json.NewDecoder(bytes.NewReader(data))}
+	if err := checker.walk(reflect.TypeOf(AtempoConfig{}), ""); err != nil {
+		return nil, fmt.Errorf("failed to parse atempo.json: %w", err)
+	}
+	return checker.unknown, nil
+}
+
+// checkStrict fails with the unknown keys listed when atempo.json has any
+func checkStrict(projectPath string) error {
+	unknown, err := FindUnknownFields(projectPath)
+	if err != nil || len(unknown) == 0 {
+		return err
+	}
+
+	lines := make([]string, len(unknown))
+	for i, field := range unknown {
+		lines[i] = "  " + field.String()
+	}
+	return fmt.Errorf("%w:\n%s", ErrUnknownFields, strings.Join(lines, "\n"))
+}
+
+// fieldChecker walks atempo.json token by token alongside the config types
+type fieldChecker struct {
+	data    []byte
+	decoder *json.Decoder
+	unknown []UnknownField
+}
+
+// walk consumes one JSON value, comparing object keys against t. A nil t accepts
+// anything (free-form values such as "command" or "ulimits").
+func (c *fieldChecker) walk(t reflect.Type, path string) error {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	token, err := c.decoder.Token()
+	if err != nil {
+		return err
+	}
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return nil // Scalar
+	}
+
+	if delim == '[' {
+		var elem reflect.Type
+		if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+			elem = t.Elem()
+		}
+		for index := 0; c.decoder.More(); index++ {
+			if err := c.walk(elem, fmt.Sprintf("%s[%d]", path, index)); err != nil {
+				return err
+			}
+		}
+		_, err := c.decoder.Token() // ']'
+		return err
+	}
+
+	for c.decoder.More() {
+		keyToken, err := c.decoder.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := keyToken.(string)
+		line := c.line()
+
+		var next reflect.Type
+		switch {
+		case t == nil || t.Kind() == reflect.Interface:
+		case t.Kind() == reflect.Map:
+			next = t.Elem()
+		case t.Kind() == reflect.Struct:
+			field, known := jsonField(t, key)
+			switch {
+			case known:
+				next = field
+			case path == "" && scaffoldKeys[key]:
+			default:
+				c.unknown = append(c.unknown, UnknownField{Key: key, Path: path, Line: line, Suggestion: closestKey(t, key)})
+			}
+		}
+
+		if err := c.walk(next, joinPath(path, key)); err != nil {
+			return err
+		}
+	}
+	_, err = c.decoder.Token() // '}'
+	return err
+}
+
+// line returns the 1-based line of the decoder's current position
+func (c *fieldChecker) line() int {
+	return bytes.Count(c.data[:c.decoder.InputOffset()], []byte("\n")) + 1
+}
+
+// jsonField returns the type of the struct field with the given JSON name, matching
+// case-insensitively as encoding/json does
+func jsonField(t reflect.Type, key string) (reflect.Type, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if name := jsonName(t.Field(i)); name != "" && strings.EqualFold(name, key) {
+			return t.Field(i).Type, true
+		}
+	}
+	return nil, false
+}
+
+// jsonName returns a struct field's JSON key, or "" if it is not serialized
+func jsonName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// closestKey suggests the known key of t nearest to key, if it is a likely typo
+func closestKey(t reflect.Type, key string) string {
+	best, bestDistance := "", 3 // Only suggest within two edits
+	for i := 0; i < t.NumField(); i++ {
+		name := jsonName(t.Field(i))
+		if name == "" {
+			continue
+		}
+		if distance := editDistance(strings.ToLower(key), name); distance < bestDistance {
+			best, bestDistance = name, distance
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+// joinPath appends key to a dotted path
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,103 @@
+package compose
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// templateVariablePattern matches {{name}} placeholders in service fields
+var templateVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// templateVars returns the variables available to service command, environment and
+// volume values
+func templateVars(projectName, projectPath, framework, serviceName string) map[string]string {
+	return map[string]string{
+		"project":   projectName,
+		"framework": framework,
+		"service":   serviceName,
+		"cwd":       projectPath,
+	}
+}
+
+// expandTemplate replaces known {{variables}} in value. Unknown variables are left
+// in place and reported so the caller can warn about them.
+func expandTemplate(value string, vars map[string]string) (string, []string) {
+	var unknown []string
+	expanded := templateVariablePattern.ReplaceAllStringFunc(value, func(match string) string {
+		name := templateVariablePattern.FindStringSubmatch(match)[1]
+		if replacement, ok := vars[name]; ok {
+			return replacement
+		}
+		unknown = append(unknown, name)
+		return match
+	})
+	return expanded, unknown
+}
+
+// expandServiceTemplates expands variables in the service's command, environment and
+// volumes, returning warnings for unknown variables
+func expandServiceTemplates(service *Service, vars map[string]string) []string {
+	unknownSet := make(map[string]bool)
+	expand := func(value string) string {
+		expanded, unknown := expandTemplate(value, vars)
+		for _, name := range unknown {
+			unknownSet[name] = true
+		}
+		return expanded
+	}
+
+	switch command := service.Command.(type) {
+	case string:
+		service.Command = expand(command)
+	case []string:
+		expanded := make([]string, len(command))
+		for i, arg := range command {
+			expanded[i] = expand(arg)
+		}
+		service.Command = expanded
+	case []interface{}:
+		expanded := make([]interface{}, len(command))
+		for i, arg := range command {
+			if str, ok := arg.(string); ok {
+				expanded[i] = expand(str)
+			} else {
+				expanded[i] = arg
+			}
+		}
+		service.Command = expanded
+	}
+
+	if len(service.Environment) > 0 {
+		environment := make(map[string]string, len(service.Environment))
+		for key, value := range service.Environment {
+			environment[key] = expand(value)
+		}
+		service.Environment = environment
+	}
+
+	if len(service.Volumes) > 0 {
+		volumes := make([]string, len(service.Volumes))
+		for i, volume := range service.Volumes {
+			volumes[i] = expand(volume)
+		}
+		service.Volumes = volumes
+	}
+
+	if len(unknownSet) == 0 {
+		return nil
+	}
+
+	known := make([]string, 0, len(vars))
+	for name := range vars {
+		known = append(known, name)
+	}
+	sort.Strings(known)
+
+	var warnings []string
+	for name := range unknownSet {
+		warnings = append(warnings, fmt.Sprintf("unknown template variable {{%s}} (available: %v)", name, known))
+	}
+	sort.Strings(warnings)
+	return warnings
+}
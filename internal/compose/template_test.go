@@ -0,0 +1,67 @@
+package compose
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExpandServiceTemplates(t *testing.T) {
+	vars := templateVars("shop", "/home/dev/shop", "laravel", "worker")
+
+	tests := []struct {
+		name        string
+		service     Service
+		wantCommand interface{}
+		wantEnv     map[string]string
+		wantWarning string // Substring of the expected warning; "" means none
+	}{
+		{
+			name:        "string command",
+			service:     Service{Command: "php artisan queue:work --name={{project}}-{{ service }}"},
+			wantCommand: "php artisan queue:work --name=shop-worker",
+		},
+		{
+			name:        "list command from atempo.json",
+			service:     Service{Command: []interface{}{"php", "artisan", "--env={{framework}}", 3}},
+			wantCommand: []interface{}{"php", "artisan", "--env=laravel", 3},
+		},
+		{
+			name:        "string list command",
+			service:     Service{Command: []string{"sh", "-c", "cd {{cwd}}"}},
+			wantCommand: []string{"sh", "-c", "cd /home/dev/shop"},
+		},
+		{
+			name:    "environment",
+			service: Service{Environment: map[string]string{"APP_NAME": "{{project}}", "DB_HOST": "{{project}}-mysql", "PLAIN": "value"}},
+			wantEnv: map[string]string{"APP_NAME": "shop", "DB_HOST": "shop-mysql", "PLAIN": "value"},
+		},
+		{
+			name:        "unknown variable is kept and reported",
+			service:     Service{Command: "run {{region}}", Environment: map[string]string{"REGION": "{{region}}"}},
+			wantCommand: "run {{region}}",
+			wantEnv:     map[string]string{"REGION": "{{region}}"},
+			wantWarning: "unknown template variable {{region}}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := tt.service
+			warnings := expandServiceTemplates(&service, vars)
+
+			if !reflect.DeepEqual(service.Command, tt.wantCommand) {
+				t.Errorf("command = %#v, want %#v", service.Command, tt.wantCommand)
+			}
+			if tt.wantEnv != nil && !reflect.DeepEqual(service.Environment, tt.wantEnv) {
+				t.Errorf("environment = %v, want %v", service.Environment, tt.wantEnv)
+			}
+			switch {
+			case tt.wantWarning == "" && len(warnings) > 0:
+				t.Errorf("unexpected warnings: %v", warnings)
+			case tt.wantWarning != "" && (len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning)):
+				t.Errorf("warnings = %v, want one containing %q", warnings, tt.wantWarning)
+			}
+		})
+	}
+}
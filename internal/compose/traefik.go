@@ -0,0 +1,107 @@
+package compose
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Host ports bound by the traefik preset
+const (
+	TraefikHTTPPort      = 8080 // Routed traffic: http://<service>.<project>.localhost:8080
+	TraefikDashboardPort = 8081 // Traefik dashboard: http://localhost:8081
+)
+
+// webContainerPorts are container ports treated as HTTP endpoints when routing through traefik
+var webContainerPorts = map[int]bool{80: true, 3000: true, 5000: true, 5173: true, 8000: true, 8080: true}
+
+// TraefikRoute describes a routing rule stamped onto a service
+type TraefikRoute struct {
+	Service string
+	Host    string
+	Port    int
+}
+
+// traefikService returns the predefined traefik reverse proxy service
+func traefikService() Service {
+	return Service{
+		Type:       "image",
+		Image:      "traefik:v3.0",
+		PullPolicy: "missing",
+		Command: []string{
+			"--providers.docker=true",
+			"--providers.docker.exposedbydefault=false",
+			"--entrypoints.web.address=:80",
+			"--api.dashboard=true",
+			"--api.insecure=true",
+		},
+		Ports: []string{
+			fmt.Sprintf("%d:80", TraefikHTTPPort),
+			fmt.Sprintf("%d:8080", TraefikDashboardPort),
+		},
+		Volumes: []string{"/var/run/docker.sock:/var/run/docker.sock:ro"},
+	}
+}
+
+// AddTraefikService adds the traefik preset to atempo.json and stamps routing labels
+// onto every existing service that publishes a web port. Each service is routed at
+// <service>.<project>.localhost, which browsers resolve to 127.0.0.1.
+func AddTraefikService(projectPath string) ([]TraefikRoute, error) {
+	config, err := LoadAtempoConfig(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Services == nil {
+		config.Services = make(map[string]Service)
+	}
+
+	projectName := config.Name
+	if projectName == "" || strings.Contains(projectName, "{{") {
+		projectName = filepath.Base(projectPath)
+	}
+
+	var routes []TraefikRoute
+	for _, serviceName := range sortedServiceNames(config.Services) {
+		if serviceName == "traefik" {
+			continue
+		}
+		service := config.Services[serviceName]
+
+		port := webContainerPort(service)
+		if port == 0 {
+			continue
+		}
+
+		router := fmt.Sprintf("%s-%s", projectName, serviceName)
+		host := fmt.Sprintf("%s.%s.localhost", serviceName, projectName)
+		if service.Labels == nil {
+			service.Labels = make(map[string]string)
+		}
+		service.Labels["traefik.enable"] = "true"
+		service.Labels[fmt.Sprintf("traefik.http.routers.%s.rule", router)] = fmt.Sprintf("Host(`%s`)", host)
+		service.Labels[fmt.Sprintf("traefik.http.routers.%s.entrypoints", router)] = "web"
+		service.Labels[fmt.Sprintf("traefik.http.services.%s.loadbalancer.server.port", router)] = fmt.Sprintf("%d", port)
+		config.Services[serviceName] = service
+
+		routes = append(routes, TraefikRoute{Service: serviceName, Host: host, Port: port})
+	}
+
+	config.Services["traefik"] = traefikService()
+
+	if err := saveAtempoConfig(config, projectPath); err != nil {
+		return nil, err
+	}
+	return routes, nil
+}
+
+// webContainerPort returns the first published container port that serves HTTP, or 0
+func webContainerPort(service Service) int {
+	for _, spec := range service.Ports {
+		mapping, ok := ParsePortMapping(spec)
+		if ok && webContainerPorts[mapping.ContainerPort] {
+			return mapping.ContainerPort
+		}
+	}
+	return 0
+}
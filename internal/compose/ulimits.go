@@ -0,0 +1,59 @@
+package compose
+
+import (
+	"fmt"
+	"sort"
+)
+
+// normalizeUlimits validates service ulimits and converts them to compose form.
+// Each limit is either a single number (soft and hard) or a map with "soft" and "hard".
+func normalizeUlimits(ulimits map[string]interface{}) (map[string]interface{}, error) {
+	names := make([]string, 0, len(ulimits))
+	for name := range ulimits {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make(map[string]interface{}, len(ulimits))
+	for _, name := range names {
+		switch value := ulimits[name].(type) {
+		case map[string]interface{}:
+			soft, softOk := ulimitValue(value["soft"])
+			hard, hardOk := ulimitValue(value["hard"])
+			if !softOk || !hardOk || len(value) != 2 {
+				return nil, fmt.Errorf("ulimit '%s' must have numeric \"soft\" and \"hard\" values", name)
+			}
+			result[name] = map[string]int{"soft": soft, "hard": hard}
+		case map[string]int:
+			soft, softOk := value["soft"]
+			hard, hardOk := value["hard"]
+			if !softOk || !hardOk || len(value) != 2 {
+				return nil, fmt.Errorf("ulimit '%s' must have \"soft\" and \"hard\" values", name)
+			}
+			result[name] = map[string]int{"soft": soft, "hard": hard}
+		default:
+			limit, ok := ulimitValue(value)
+			if !ok {
+				return nil, fmt.Errorf("ulimit '%s' must be a number or an object with \"soft\" and \"hard\"", name)
+			}
+			result[name] = limit
+		}
+	}
+
+	return result, nil
+}
+
+// ulimitValue converts a JSON or Go number to an int limit (-1 means unlimited)
+func ulimitValue(value interface{}) (int, bool) {
+	switch number := value.(type) {
+	case int:
+		return number, true
+	case float64:
+		if number != float64(int(number)) {
+			return 0, false
+		}
+		return int(number), true
+	default:
+		return 0, false
+	}
+}
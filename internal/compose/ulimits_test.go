@@ -0,0 +1,42 @@
+package compose
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestElasticsearchPresetUlimits(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "atempo.json", `{"name": "shop", "framework": "laravel", "services": {}}`)
+
+	// Go through atempo.json so the limits survive the JSON round trip
+	if err := AddPredefinedService(dir, "elasticsearch"); err != nil {
+		t.Fatalf("AddPredefinedService: %v", err)
+	}
+	content, _, err := RenderDockerCompose(dir, GenerateOptions{})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	writeFile(t, dir, "docker-compose.yml", content)
+
+	service := readCompose(t, dir).Services["elasticsearch"]
+	ulimits := lookup(t, service, "ulimits")
+	want := map[string]interface{}{
+		"memlock": map[string]interface{}{"soft": -1, "hard": -1},
+		"nofile":  map[string]interface{}{"soft": 65536, "hard": 65536},
+	}
+	if !reflect.DeepEqual(ulimits, want) {
+		t.Errorf("ulimits = %#v, want %#v", ulimits, want)
+	}
+}
+
+func TestNormalizeUlimitsRejectsInvalidValues(t *testing.T) {
+	for name, ulimits := range map[string]map[string]interface{}{
+		"missing hard": {"nofile": map[string]interface{}{"soft": 1024.0}},
+		"not a number": {"nproc": "lots"},
+	} {
+		if _, err := normalizeUlimits(ulimits); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package compose
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DefaultComposeVersion is the file format version written when none is configured
+const DefaultComposeVersion = "3.8"
+
+// ComposeVersionNone omits the version field, as the Compose Specification expects
+const ComposeVersionNone = "none"
+
+var composeVersionPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// resolveComposeVersion returns the version to emit, preferring the override over
+// atempo.json's compose_version. An empty result means the field is omitted.
+func resolveComposeVersion(override, configured string) (string, error) {
+	version := override
+	if version == "" {
+		version = configured
+	}
+	if version == "" {
+		return DefaultComposeVersion, nil
+	}
+	if version == ComposeVersionNone {
+		return "", nil
+	}
+	if !composeVersionPattern.MatchString(version) {
+		return "", fmt.Errorf("invalid compose version '%s' (expected e.g. 3.8, or 'none' to omit it)", version)
+	}
+	return version, nil
+}
@@ -0,0 +1,82 @@
+package compose
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// windowsDrivePattern matches a Windows drive prefix such as "C:\" or "d:/"
+var windowsDrivePattern = regexp.MustCompile(`^[A-Za-z]:[\\/]`)
+
+// normalizeServiceVolumes rewrites bind-mount host paths into a clean, portable form:
+// forward slashes, no redundant segments, and relative paths prefixed with "./".
+// Relative paths stay relative because compose resolves them against the directory
+// of docker-compose.yml (the project root), never the caller's working directory,
+// which keeps the generated file identical on every machine. Named volumes are left
+// untouched; an undeclared one that matches a project directory gets a warning, since
+// a bind mount ("./name") was probably intended.
+func normalizeServiceVolumes(service *Service, projectPath string, declared map[string]Volume) []string {
+	var warnings []string
+
+	for i, spec := range service.Volumes {
+		host, rest, ok := splitVolumeSpec(spec)
+		if !ok {
+			continue // Anonymous volume such as "/var/lib/data"
+		}
+
+		if isNamedVolume(host) {
+			if _, isDeclared := declared[host]; isDeclared {
+				continue
+			}
+			if info, err := os.Stat(filepath.Join(projectPath, host)); err == nil && info.IsDir() {
+				warnings = append(warnings, fmt.Sprintf("volume '%s' uses undeclared named volume '%s' although ./%s exists (use './%s%s' to bind-mount it, or declare the volume)", spec, host, host, host, rest))
+			}
+			continue
+		}
+
+		service.Volumes[i] = normalizeHostPath(host) + rest
+	}
+
+	return warnings
+}
+
+// splitVolumeSpec splits "host:container[:mode]" into the host part and the rest
+// (starting with ':'). It reports false for specs with no host part.
+func splitVolumeSpec(spec string) (string, string, bool) {
+	offset := 0
+	if windowsDrivePattern.MatchString(spec) {
+		offset = 2
+	}
+
+	index := strings.Index(spec[offset:], ":")
+	if index < 0 {
+		return "", spec, false
+	}
+	return spec[:offset+index], spec[offset+index:], true
+}
+
+// isNamedVolume reports whether a volume host part is a volume name rather than a path
+func isNamedVolume(host string) bool {
+	return !strings.ContainsAny(host, `/\`) && !strings.HasPrefix(host, ".") && !strings.HasPrefix(host, "~")
+}
+
+// normalizeHostPath cleans a bind-mount host path and uses forward slashes
+func normalizeHostPath(host string) string {
+	if strings.HasPrefix(host, "~") || strings.HasPrefix(host, "$") {
+		return host // Expanded by compose or the shell
+	}
+
+	cleaned := path.Clean(strings.ReplaceAll(host, `\`, "/"))
+
+	if windowsDrivePattern.MatchString(cleaned) || strings.HasPrefix(cleaned, "/") {
+		return cleaned
+	}
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return cleaned
+	}
+	return "./" + cleaned
+}
@@ -0,0 +1,54 @@
+package compose
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeServiceVolumes(t *testing.T) {
+	projectPath := t.TempDir()
+	if err := os.Mkdir(filepath.Join(projectPath, "storage"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	declared := map[string]Volume{"mysql_data": {}}
+
+	tests := []struct {
+		spec        string
+		want        string
+		wantWarning string // Substring of the expected warning; "" means none
+	}{
+		{spec: "./src:/var/www", want: "./src:/var/www"},
+		{spec: "src:/var/www", want: "src:/var/www"}, // Named volume, no such directory
+		{spec: "./src/../src/:/var/www:ro", want: "./src:/var/www:ro"},
+		{spec: "../shared:/shared", want: "../shared:/shared"},
+		{spec: "../shared/./lib:/lib", want: "../shared/lib:/lib"},
+		{spec: `.\infra\nginx:/etc/nginx`, want: "./infra/nginx:/etc/nginx"},
+		{spec: "/var/run/docker.sock:/var/run/docker.sock", want: "/var/run/docker.sock:/var/run/docker.sock"},
+		{spec: "mysql_data:/var/lib/mysql", want: "mysql_data:/var/lib/mysql"},
+		{spec: "/var/lib/data", want: "/var/lib/data"}, // Anonymous volume
+		{
+			spec:        "storage:/var/www/storage",
+			want:        "storage:/var/www/storage",
+			wantWarning: "use './storage:/var/www/storage' to bind-mount it",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			service := Service{Volumes: []string{tt.spec}}
+			warnings := normalizeServiceVolumes(&service, projectPath, declared)
+
+			if got := service.Volumes[0]; got != tt.want {
+				t.Errorf("volume = %q, want %q", got, tt.want)
+			}
+			switch {
+			case tt.wantWarning == "" && len(warnings) > 0:
+				t.Errorf("unexpected warnings: %v", warnings)
+			case tt.wantWarning != "" && (len(warnings) != 1 || !strings.Contains(warnings[0], tt.wantWarning)):
+				t.Errorf("warnings = %v, want one containing %q", warnings, tt.wantWarning)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Settings are the user's atempo settings, stored as dotted keys such as
+// "defaults.laravel.version" in ~/.atempo/config.json
+type Settings map[string]string
+
+// GetConfigPath returns the path of the user settings file
+func GetConfigPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".atempo", "config.json"), nil
+}
+
+// Load reads the user settings. A missing file yields no settings.
+func Load() (Settings, error) {
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(configPath)
+	if os.IsNotExist(err) {
+		return Settings{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", configPath, err)
+	}
+
+	settings := Settings{}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", configPath, err)
+	}
+	return settings, nil
+}
+
+// Get returns the value of a setting, or "" when it is not set or unreadable
+func Get(key string) string {
+	settings, err := Load()
+	if err != nil {
+		return ""
+	}
+	return settings[key]
+}
+
+// Save writes the settings to disk
+func (s Settings) Save() error {
+	configPath, err := GetConfigPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return fmt.Errorf("failed to create atempo directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize settings: %w", err)
+	}
+	return os.WriteFile(configPath, data, 0644)
+}
+
+// Keys returns the setting names in sorted order
+func (s Settings) Keys() []string {
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -2,14 +2,19 @@ package docker
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
 
+	"atempo/internal/compose"
+	"atempo/internal/ui"
 	"atempo/internal/utils"
 )
 
@@ -21,10 +26,23 @@ var (
 
 // DockerCommand represents available Docker operations
 type DockerCommand struct {
-	Name        string
-	Description string
-	Args        []string
-	Timeout     time.Duration // Default timeout for this command
+	Name         string
+	Description  string
+	Args         []string
+	Timeout      time.Duration          // Default timeout for this command
+	ComposeFiles []string               // Explicit compose files; bypasses docker-compose.yml discovery
+	Output       io.Writer              // Receives compose output instead of the terminal (stdin is not attached)
+	LineFilter   func(line string) bool // When set, only output lines it accepts are shown
+
+	timeoutOverridden bool // Set by --timeout; disables the build-flag extension
+}
+
+// BuildFlagTimeouts are the minimum timeouts for commands run with build-heavy flags.
+// A --no-cache rebuild starts from scratch, so it gets the most time; only 'build'
+// accepts it. An explicit --timeout always wins.
+var BuildFlagTimeouts = map[string]time.Duration{
+	"--build":    10 * time.Minute,
+	"--no-cache": 25 * time.Minute,
 }
 
 // Common Docker commands for Atempo projects
@@ -75,6 +93,13 @@ var SupportedCommands = map[string]DockerCommand{
 		Name:        "pull",
 		Description: "Pull service images",
 		Args:        []string{"pull"},
+		Timeout:     15 * time.Minute, // Large images can take a long time to download
+	},
+	"push": {
+		Name:        "push",
+		Description: "Push built service images",
+		Args:        []string{"push"},
+		Timeout:     10 * time.Minute, // Long timeout for uploading layers
 	},
 }
 
@@ -100,11 +125,54 @@ func ExecuteWithCustomTimeout(command string, projectPath string, additionalArgs
 	
 	// Override the timeout
 	dockerCmd.Timeout = customTimeout
+	dockerCmd.timeoutOverridden = true
 	
 	// Use the same logic but with custom timeout
 	return executeWithCommand(dockerCmd, projectPath, additionalArgs)
 }
 
+// ExecuteWithComposeFiles runs a command against explicit compose files (later files
+// override earlier ones, as with 'docker compose -f'). A zero timeout keeps the default.
+func ExecuteWithComposeFiles(command string, projectPath string, additionalArgs []string, composeFiles []string, customTimeout time.Duration) error {
+	dockerCmd, exists := SupportedCommands[command]
+	if !exists {
+		return fmt.Errorf("unsupported Docker command: %s", command)
+	}
+
+	if customTimeout > 0 {
+		dockerCmd.Timeout = customTimeout
+		dockerCmd.timeoutOverridden = true
+	}
+	dockerCmd.ComposeFiles = composeFiles
+
+	return executeWithCommand(dockerCmd, projectPath, additionalArgs)
+}
+
+// ExecuteWithOutput runs a command with its output written to out rather than the
+// terminal, so commands for several projects can run at once without interleaving
+func ExecuteWithOutput(command string, projectPath string, additionalArgs []string, out io.Writer) error {
+	dockerCmd, exists := SupportedCommands[command]
+	if !exists {
+		return fmt.Errorf("unsupported Docker command: %s", command)
+	}
+
+	dockerCmd.Output = out
+	return executeWithCommand(dockerCmd, projectPath, additionalArgs)
+}
+
+// ExecuteWithLineFilter runs a command showing only the output lines keep accepts
+// (e.g. 'logs --grep'). Compose keeps its colors on a terminal despite the filtering.
+func ExecuteWithLineFilter(command string, projectPath string, additionalArgs []string, composeFiles []string, keep func(string) bool) error {
+	dockerCmd, exists := SupportedCommands[command]
+	if !exists {
+		return fmt.Errorf("unsupported Docker command: %s", command)
+	}
+
+	dockerCmd.ComposeFiles = composeFiles
+	dockerCmd.LineFilter = keep
+	return executeWithCommand(dockerCmd, projectPath, additionalArgs)
+}
+
 // executeWithCommand is the core execution logic extracted for reuse
 func executeWithCommand(dockerCmd DockerCommand, projectPath string, additionalArgs []string) error {
 	// Resolve project path
@@ -113,68 +181,198 @@ func executeWithCommand(dockerCmd DockerCommand, projectPath string, additionalA
 		return fmt.Errorf("failed to resolve project path: %w", err)
 	}
 
-	// Look for docker-compose.yml in project root first (new architecture)
-	rootComposePath := filepath.Join(resolvedPath, "docker-compose.yml")
+	// Warn about host ports already taken by other projects before starting
+	if dockerCmd.Name == "up" {
+		warnOutput := dockerCmd.Output
+		if warnOutput == nil {
+			warnOutput = os.Stdout
+		}
+		warnPortConflicts(warnOutput, resolvedPath)
+	}
+
 	dockerDir := resolvedPath
-	var composeFile string
-	
-	if utils.FileExists(rootComposePath) {
-		// Use compose file in project root
-		composeFile = "docker-compose.yml"
+	var baseArgs []string
+
+	if len(dockerCmd.ComposeFiles) > 0 {
+		for _, composeFile := range dockerCmd.ComposeFiles {
+			if !utils.FileExists(composeFile) {
+				return fmt.Errorf("compose file not found: %s", composeFile)
+			}
+			baseArgs = append(baseArgs, "-f", composeFile)
+		}
 	} else {
-		// Fallback: check infra/docker subdirectory for legacy projects
-		legacyDockerDir := filepath.Join(resolvedPath, "infra", "docker")
-		legacyComposePath := filepath.Join(legacyDockerDir, "docker-compose.yml")
-		if !utils.FileExists(legacyComposePath) {
-			return fmt.Errorf("docker-compose.yml not found in %s or %s", resolvedPath, legacyDockerDir)
+		// Look for docker-compose.yml in project root first (new architecture)
+		rootComposePath := filepath.Join(resolvedPath, "docker-compose.yml")
+		var composeFile string
+
+		if utils.FileExists(rootComposePath) {
+			// Use compose file in project root
+			composeFile = "docker-compose.yml"
+		} else {
+			// Fallback: check infra/docker subdirectory for legacy projects
+			legacyDockerDir := filepath.Join(resolvedPath, "infra", "docker")
+			legacyComposePath := filepath.Join(legacyDockerDir, "docker-compose.yml")
+			if !utils.FileExists(legacyComposePath) {
+				return fmt.Errorf("%w in %s or %s", ErrComposeFileNotFound, resolvedPath, legacyDockerDir)
+			}
+			// Use compose file in subdirectory with -f flag, but run from project root
+			composeFile = "infra/docker/docker-compose.yml"
 		}
-		// Use compose file in subdirectory with -f flag, but run from project root
-		composeFile = "infra/docker/docker-compose.yml"
+
+		// Build the full command with -f flag for compose file location
+		baseArgs = []string{"-f", composeFile}
+	}
+
+	// Compose stops coloring when its output isn't a terminal, as with a filter
+	if dockerCmd.LineFilter != nil && ui.ColorEnabled() {
+		baseArgs = append(baseArgs, "--ansi", "always")
 	}
 
-	// Build the full command with -f flag for compose file location
-	baseArgs := []string{"-f", composeFile}
 	args := append(baseArgs, dockerCmd.Args...)
 	args = append(args, additionalArgs...)
-	fullCommand := append([]string{"docker-compose"}, args...)
+	fullCommand := utils.ComposeArgs(args...)
 
 	// Create context with timeout
 	var ctx context.Context
 	var cancel context.CancelFunc
 	
+	printf := ui.Printf
+	if dockerCmd.Output != nil {
+		printf = func(format string, a ...interface{}) {
+			fmt.Fprintf(dockerCmd.Output, format, a...)
+		}
+	}
+
+	var extendedFor string
+	if !dockerCmd.timeoutOverridden && dockerCmd.Timeout > 0 {
+		dockerCmd.Timeout, extendedFor = buildFlagTimeout(dockerCmd.Timeout, args)
+	}
+
 	if dockerCmd.Timeout > 0 {
 		ctx, cancel = context.WithTimeout(context.Background(), dockerCmd.Timeout)
 		defer cancel()
-		fmt.Printf("→ Running: %s (in %s, timeout: %v)\n", strings.Join(fullCommand, " "), dockerDir, dockerCmd.Timeout)
+		if extendedFor != "" {
+			printf("→ Running: %s (in %s, timeout: %v for %s; use --timeout to change)\n", strings.Join(fullCommand, " "), dockerDir, dockerCmd.Timeout, extendedFor)
+		} else {
+			printf("→ Running: %s (in %s, timeout: %v)\n", strings.Join(fullCommand, " "), dockerDir, dockerCmd.Timeout)
+		}
 	} else {
 		ctx = context.Background()
-		fmt.Printf("→ Running: %s (in %s, no timeout)\n", strings.Join(fullCommand, " "), dockerDir)
+		printf("→ Running: %s (in %s, no timeout)\n", strings.Join(fullCommand, " "), dockerDir)
 	}
 
 	// Execute the command with timeout
 	cmd := exec.CommandContext(ctx, fullCommand[0], fullCommand[1:]...)
 	cmd.Dir = dockerDir
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	cmd.Stdin = os.Stdin
+	if dockerCmd.Output != nil {
+		cmd.Stdout = dockerCmd.Output
+		cmd.Stderr = dockerCmd.Output
+	} else if dockerCmd.LineFilter != nil {
+		filter := NewLineFilter(os.Stdout, dockerCmd.LineFilter)
+		defer filter.Flush()
+		cmd.Stdout = filter
+		cmd.Stderr = os.Stderr
+	} else {
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		cmd.Stdin = os.Stdin
+	}
 	
 	// Setup Bake environment for build commands
 	if dockerCmd.Name == "up" || dockerCmd.Name == "build" {
 		setupBakeEnvironment(cmd)
 	}
 
-	err = cmd.Run()
+	// Ctrl+C is forwarded to compose so it can stop cleanly
+	err = runForwardingSignals(cmd)
+	if errors.Is(err, ErrInterrupted) {
+		return err
+	}
 	
 	// Check if the command was cancelled due to timeout
 	if ctx.Err() == context.DeadlineExceeded {
 		return fmt.Errorf("command timed out after %v", dockerCmd.Timeout)
 	}
+
+	// A stopped daemon is the most common cause of compose failures; say so, keeping compose's error
+	if err != nil && errors.Is(CheckDaemon(), ErrDaemonNotRunning) {
+		return fmt.Errorf("%w: %v", ErrDaemonNotRunning, err)
+	}
 	
 	return err
 }
 
-// ExecuteExecCommand runs a command inside a container (docker-compose exec)
-func ExecuteExecCommand(service string, projectPath string, cmdArgs []string) error {
+// buildFlagTimeout raises timeout to the largest BuildFlagTimeouts entry whose flag
+// appears in args, returning the new timeout and the flag responsible ("" if unchanged)
+func buildFlagTimeout(timeout time.Duration, args []string) (time.Duration, string) {
+	var extendedFor string
+	for _, arg := range args {
+		if minimum, ok := BuildFlagTimeouts[arg]; ok && minimum > timeout {
+			timeout = minimum
+			extendedFor = arg
+		}
+	}
+	return timeout, extendedFor
+}
+
+// warnPortConflicts prints a warning for each host port that is already in use.
+// It never blocks the command; docker-compose reports the definitive error.
+func warnPortConflicts(out io.Writer, projectPath string) {
+	conflicts, err := CheckPortConflicts(projectPath)
+	if err != nil || len(conflicts) == 0 {
+		return
+	}
+
+	fmt.Fprintln(out, "⚠️  Port conflicts detected:")
+	for _, conflict := range conflicts {
+		fmt.Fprintf(out, "  • localhost:%d (%s) is already used by %s\n", conflict.Port, conflict.Service, conflict.UsedBy)
+	}
+	fmt.Fprintln(out, "  Change the ports in atempo.json and run 'atempo reconfigure' to reallocate them.")
+}
+
+// envKeyPattern matches valid environment variable names
+var envKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ValidateEnvAssignment checks that an environment override has the KEY=VALUE format
+func ValidateEnvAssignment(assignment string) error {
+	key, _, found := strings.Cut(assignment, "=")
+	if !found {
+		return fmt.Errorf("invalid environment variable '%s': expected KEY=VALUE", assignment)
+	}
+	if !envKeyPattern.MatchString(key) {
+		return fmt.Errorf("invalid environment variable name '%s': use letters, digits and underscores, not starting with a digit", key)
+	}
+	return nil
+}
+
+// ExecOptions are the docker-compose exec flags atempo forwards
+type ExecOptions struct {
+	Env     []string // KEY=VALUE assignments, forwarded with -e
+	User    string   // Run as this user (--user), e.g. root in an image that drops privileges
+	Workdir string   // Working directory inside the container (--workdir)
+}
+
+// composeArgs validates the options and renders them as compose exec flags
+func (o ExecOptions) composeArgs() ([]string, error) {
+	var args []string
+	for _, assignment := range o.Env {
+		if err := ValidateEnvAssignment(assignment); err != nil {
+			return nil, err
+		}
+		args = append(args, "-e", assignment)
+	}
+	if o.User != "" {
+		args = append(args, "--user", o.User)
+	}
+	if o.Workdir != "" {
+		args = append(args, "--workdir", o.Workdir)
+	}
+	return args, nil
+}
+
+// ExecuteExecCommand runs a command inside a container (docker-compose exec),
+// forwarding the env, user and working directory given in opts
+func ExecuteExecCommand(service string, projectPath string, opts ExecOptions, cmdArgs []string) error {
 	// Resolve project path
 	resolvedPath, err := resolveProjectPath(projectPath)
 	if err != nil {
@@ -184,13 +382,20 @@ func ExecuteExecCommand(service string, projectPath string, cmdArgs []string) er
 	// Validate that docker-compose.yml exists
 	composePath := filepath.Join(resolvedPath, "docker-compose.yml")
 	if !utils.FileExists(composePath) {
-		return fmt.Errorf("docker-compose.yml not found in %s", resolvedPath)
+		return fmt.Errorf("%w in %s", ErrComposeFileNotFound, resolvedPath)
 	}
 
 	// Build the exec command
-	args := append([]string{"docker-compose", "exec", service}, cmdArgs...)
+	execFlags, err := opts.composeArgs()
+	if err != nil {
+		return err
+	}
+	args := utils.ComposeArgs("exec")
+	args = append(args, execFlags...)
+	args = append(args, service)
+	args = append(args, cmdArgs...)
 
-	fmt.Printf("→ Running: %s (in %s)\n", strings.Join(args, " "), resolvedPath)
+	ui.Printf("→ Running: %s (in %s)\n", strings.Join(args, " "), resolvedPath)
 
 	// Execute the command
 	cmd := exec.Command(args[0], args[1:]...)
@@ -202,6 +407,77 @@ func ExecuteExecCommand(service string, projectPath string, cmdArgs []string) er
 	return cmd.Run()
 }
 
+// ExecResult is the outcome of running a command in one service
+type ExecResult struct {
+	Service string
+	Err     error
+}
+
+// ComposeServices returns the service names defined in the project's docker-compose.yml
+func ComposeServices(projectPath string) ([]string, error) {
+	resolvedPath, err := resolveProjectPath(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project path: %w", err)
+	}
+
+	if !utils.FileExists(filepath.Join(resolvedPath, "docker-compose.yml")) {
+		return nil, fmt.Errorf("%w in %s", ErrComposeFileNotFound, resolvedPath)
+	}
+
+	cmd := utils.ComposeCommand("config", "--services")
+	cmd.Dir = resolvedPath
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	var services []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			services = append(services, name)
+		}
+	}
+	return services, nil
+}
+
+// ExecuteExecAll runs a non-interactive command in every service, one after another,
+// and returns the result for each. A failing service does not stop the others.
+func ExecuteExecAll(projectPath string, opts ExecOptions, cmdArgs []string) ([]ExecResult, error) {
+	execFlags, err := opts.composeArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := ComposeServices(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvedPath, err := resolveProjectPath(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project path: %w", err)
+	}
+
+	results := make([]ExecResult, 0, len(services))
+	for _, service := range services {
+		args := utils.ComposeArgs("exec", "-T")
+		args = append(args, execFlags...)
+		args = append(args, service)
+		args = append(args, cmdArgs...)
+
+		ui.Printf("\n→ %s: %s\n", service, strings.Join(cmdArgs, " "))
+
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = resolvedPath
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		results = append(results, ExecResult{Service: service, Err: cmd.Run()})
+	}
+
+	return results, nil
+}
+
 // ListServices shows available services in the docker-compose.yml
 func ListServices(projectPath string) error {
 	// Resolve project path
@@ -213,13 +489,13 @@ func ListServices(projectPath string) error {
 	// Validate that docker-compose.yml exists
 	composePath := filepath.Join(resolvedPath, "docker-compose.yml")
 	if !utils.FileExists(composePath) {
-		return fmt.Errorf("docker-compose.yml not found in %s", resolvedPath)
+		return fmt.Errorf("%w in %s", ErrComposeFileNotFound, resolvedPath)
 	}
 
-	fmt.Printf("→ Services in %s:\n", resolvedPath)
+	ui.Printf("→ Services in %s:\n", resolvedPath)
 
 	// Run docker-compose config --services
-	cmd := exec.Command("docker-compose", "config", "--services")
+	cmd := utils.ComposeCommand("config", "--services")
 	cmd.Dir = resolvedPath
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -232,8 +508,8 @@ func resolveProjectPath(projectPath string) (string, error) {
 	var targetPath string
 
 	if projectPath == "" {
-		// Use current working directory
-		cwd, err := os.Getwd()
+		// Use the project containing the current working directory
+		cwd, err := utils.CurrentProjectDir()
 		if err != nil {
 			return "", fmt.Errorf("failed to get current directory: %w", err)
 		}
@@ -264,12 +540,12 @@ func resolveProjectPath(projectPath string) (string, error) {
 func ValidateDockerCompose() error {
 	// Check if docker is available
 	if _, err := exec.LookPath("docker"); err != nil {
-		return fmt.Errorf("docker command not found. Please install Docker")
+		return ErrDockerNotInstalled
 	}
 
-	// Check if docker-compose is available
-	if _, err := exec.LookPath("docker-compose"); err != nil {
-		return fmt.Errorf("docker-compose command not found. Please install Docker Compose")
+	// Check if docker-compose or the 'docker compose' plugin is available
+	if !utils.ComposeAvailable() {
+		return ErrComposeNotInstalled
 	}
 
 	return nil
@@ -294,9 +570,53 @@ func DetectFramework(projectPath string) (string, error) {
 		return "django", nil
 	}
 
+	// Code may be mounted from elsewhere; ask the running app container instead
+	if framework := detectFrameworkFromContainer(resolvedPath); framework != "" {
+		return framework, nil
+	}
+
 	return "unknown", nil
 }
 
+// containerFrameworkProbes maps each framework to its app service and a command that
+// succeeds only inside that framework's container
+var containerFrameworkProbes = []struct {
+	framework string
+	command   []string
+}{
+	{"laravel", []string{"php", "artisan", "--version"}},
+	{"django", []string{"python", "-c", "import django"}},
+}
+
+// detectFrameworkFromContainer probes the running app containers of a project and
+// returns the framework whose probe succeeds, or "" when none does (or nothing runs)
+func detectFrameworkFromContainer(projectPath string) string {
+	if !utils.FileExists(filepath.Join(projectPath, "docker-compose.yml")) {
+		return ""
+	}
+
+	for _, probe := range containerFrameworkProbes {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		args := utils.ComposeArgs("exec", "-T", GetAppService(probe.framework))
+		args = append(args, probe.command...)
+
+		cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+		cmd.Dir = projectPath
+		err := cmd.Run()
+		cancel()
+
+		if err == nil {
+			return probe.framework
+		}
+	}
+	return ""
+}
+
+// GetAppService returns the container that runs the framework's application code
+func GetAppService(framework string) string {
+	return compose.AppService(framework)
+}
+
 // GetFrameworkServices returns common services for different frameworks
 func GetFrameworkServices(framework string) []string {
 	switch framework {
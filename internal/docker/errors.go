@@ -0,0 +1,42 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"time"
+)
+
+// daemonProbeTimeout bounds 'docker info' in CheckDaemon; an unresponsive daemon counts as not running
+const daemonProbeTimeout = 5 * time.Second
+
+// Sentinel errors returned by this package; match them with errors.Is
+var (
+	// ErrDockerNotInstalled means the docker CLI is not on PATH
+	ErrDockerNotInstalled = errors.New("docker command not found. Please install Docker")
+
+	// ErrComposeNotInstalled means neither docker-compose nor the compose plugin is available
+	ErrComposeNotInstalled = errors.New("neither docker-compose nor the 'docker compose' plugin was found. Please install Docker Compose")
+
+	// ErrDaemonNotRunning means the docker CLI exists but cannot reach the daemon
+	ErrDaemonNotRunning = errors.New("docker daemon is not running")
+
+	// ErrComposeFileNotFound means the project has no docker-compose.yml
+	ErrComposeFileNotFound = errors.New("docker-compose.yml not found")
+
+	// ErrInterrupted means a compose command was stopped by SIGINT/SIGTERM
+	ErrInterrupted = errors.New("interrupted")
+)
+
+// CheckDaemon reports whether the Docker daemon is reachable
+func CheckDaemon() error {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return ErrDockerNotInstalled
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), daemonProbeTimeout)
+	defer cancel()
+	if err := exec.CommandContext(ctx, "docker", "info").Run(); err != nil {
+		return ErrDaemonNotRunning
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"atempo/internal/utils"
+)
+
+// BuildImages returns the images of services that are built locally, read from the
+// project's docker-compose.yml. Pulled images (mysql, redis, ...) are not included.
+func BuildImages(projectPath string) ([]string, error) {
+	resolvedPath, err := resolveProjectPath(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project path: %w", err)
+	}
+
+	composePath := filepath.Join(resolvedPath, "docker-compose.yml")
+	if !utils.FileExists(composePath) {
+		composePath = filepath.Join(resolvedPath, "infra", "docker", "docker-compose.yml")
+	}
+
+	data, err := os.ReadFile(composePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read docker-compose.yml: %w", err)
+	}
+
+	var composeFile struct {
+		Services map[string]struct {
+			Image string      `yaml:"image"`
+			Build interface{} `yaml:"build"`
+		} `yaml:"services"`
+	}
+	if err := yaml.Unmarshal(data, &composeFile); err != nil {
+		return nil, fmt.Errorf("failed to parse docker-compose.yml: %w", err)
+	}
+
+	var images []string
+	for _, service := range composeFile.Services {
+		if service.Build != nil && service.Image != "" {
+			images = append(images, service.Image)
+		}
+	}
+	sort.Strings(images)
+	return images, nil
+}
+
+// RemoveImages removes the given images, skipping ones that don't exist
+func RemoveImages(images []string) error {
+	var failed []string
+	for _, image := range images {
+		if err := exec.Command("docker", "image", "inspect", image).Run(); err != nil {
+			continue // Never built or already removed
+		}
+
+		fmt.Printf("→ Removing image %s\n", image)
+		cmd := exec.Command("docker", "rmi", image)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			failed = append(failed, image)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to remove images: %v", failed)
+	}
+	return nil
+}
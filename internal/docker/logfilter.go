@@ -0,0 +1,76 @@
+package docker
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"sync"
+)
+
+// ansiPattern matches terminal color escape sequences
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// LineFilter is an io.Writer that passes through only the complete lines for which
+// keep returns true. Lines are matched without color codes but written unchanged,
+// so compose's colorized output survives filtering.
+type LineFilter struct {
+	out     io.Writer
+	keep    func(line string) bool
+	pending []byte
+	mu      sync.Mutex
+}
+
+// NewLineFilter wraps out so only lines accepted by keep are written
+func NewLineFilter(out io.Writer, keep func(line string) bool) *LineFilter {
+	return &LineFilter{out: out, keep: keep}
+}
+
+// GrepFilter returns a keep function matching pattern, or rejecting it when invert is set
+func GrepFilter(pattern *regexp.Regexp, invert bool) func(string) bool {
+	return func(line string) bool {
+		return pattern.MatchString(line) != invert
+	}
+}
+
+// Write buffers p and writes out every complete line that passes the filter
+func (f *LineFilter) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.pending = append(f.pending, p...)
+	for {
+		index := bytes.IndexByte(f.pending, '\n')
+		if index < 0 {
+			break
+		}
+		line := f.pending[:index+1]
+		if err := f.writeLine(line); err != nil {
+			return len(p), err
+		}
+		f.pending = f.pending[index+1:]
+	}
+	return len(p), nil
+}
+
+// Flush writes a trailing line that had no newline, if it passes the filter
+func (f *LineFilter) Flush() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.pending) == 0 {
+		return nil
+	}
+	line := f.pending
+	f.pending = nil
+	return f.writeLine(line)
+}
+
+// writeLine writes line when its text, stripped of color codes, is kept
+func (f *LineFilter) writeLine(line []byte) error {
+	text := ansiPattern.ReplaceAllString(string(bytes.TrimRight(line, "\r\n")), "")
+	if !f.keep(text) {
+		return nil
+	}
+	_, err := f.out.Write(line)
+	return err
+}
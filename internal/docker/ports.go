@@ -0,0 +1,125 @@
+package docker
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"atempo/internal/compose"
+	"atempo/internal/registry"
+)
+
+// PortConflict describes a host port requested by a project that is already taken
+type PortConflict struct {
+	Port    int    // Host port
+	Service string // Service in this project requesting the port
+	UsedBy  string // Project or container currently holding the port
+}
+
+// publishedPortPattern matches published ports in `docker ps` output, e.g. "0.0.0.0:8000->80/tcp"
+var publishedPortPattern = regexp.MustCompile(`:(\d+)->`)
+
+// CheckPortConflicts compares the host ports declared in a project's atempo.json with
+// ports stored for other registered projects and ports published by running containers
+// that don't belong to this project.
+func CheckPortConflicts(projectPath string) ([]PortConflict, error) {
+	config, err := compose.LoadAtempoConfig(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Host ports requested by this project
+	requested := make(map[int]string)
+	for serviceName, service := range config.Services {
+		for _, spec := range service.Ports {
+			if mapping, ok := compose.ParsePortMapping(spec); ok {
+				requested[mapping.HostPort] = serviceName
+			}
+		}
+	}
+	if len(requested) == 0 {
+		return nil, nil
+	}
+
+	inUse := make(map[int]string)
+
+	// Ports stored for other registered projects that were last seen running
+	if reg, err := registry.LoadRegistry(); err == nil {
+		for _, project := range reg.ListProjects() {
+			if samePath(project.Path, projectPath) {
+				continue
+			}
+			if project.Status != "running" && project.Status != "partial" {
+				continue
+			}
+			for _, port := range project.Ports {
+				inUse[port.External] = fmt.Sprintf("project '%s'", project.Name)
+			}
+		}
+	}
+
+	// Ports published by running containers outside this project
+	for port, owner := range runningContainerPorts(projectPath) {
+		if _, exists := inUse[port]; !exists {
+			inUse[port] = owner
+		}
+	}
+
+	var conflicts []PortConflict
+	for port, serviceName := range requested {
+		if owner, taken := inUse[port]; taken {
+			conflicts = append(conflicts, PortConflict{
+				Port:    port,
+				Service: serviceName,
+				UsedBy:  owner,
+			})
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Port < conflicts[j].Port
+	})
+
+	return conflicts, nil
+}
+
+// runningContainerPorts returns host ports published by running containers that
+// don't belong to the compose project in projectPath, keyed by port
+func runningContainerPorts(projectPath string) map[int]string {
+	ports := make(map[int]string)
+
+	cmd := exec.Command("docker", "ps", "--format", `{{.Label "com.docker.compose.project.working_dir"}}	{{.Names}}	{{.Ports}}`)
+	output, err := cmd.Output()
+	if err != nil {
+		return ports
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		workingDir, containerName, published := fields[0], fields[1], fields[2]
+		if workingDir != "" && samePath(workingDir, projectPath) {
+			continue
+		}
+
+		for _, match := range publishedPortPattern.FindAllStringSubmatch(published, -1) {
+			if port, err := strconv.Atoi(match[1]); err == nil {
+				ports[port] = fmt.Sprintf("container '%s'", containerName)
+			}
+		}
+	}
+
+	return ports
+}
+
+// samePath reports whether two paths refer to the same directory
+func samePath(a, b string) bool {
+	return filepath.Clean(a) == filepath.Clean(b)
+}
@@ -0,0 +1,125 @@
+package docker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"atempo/internal/utils"
+)
+
+// ContainerStatus is one service container as reported by 'docker-compose ps'
+type ContainerStatus struct {
+	Service string          `json:"service"`
+	Name    string          `json:"name"`
+	State   string          `json:"state"`
+	Health  string          `json:"health,omitempty"`
+	Ports   []PublishedPort `json:"ports"`
+}
+
+// PublishedPort is a container port published on the host
+type PublishedPort struct {
+	HostIP    string `json:"host_ip,omitempty"`
+	Published int    `json:"published"`
+	Target    int    `json:"target"`
+	Protocol  string `json:"protocol"`
+}
+
+// composePsEntry mirrors the fields of 'docker-compose ps --format json' that we use
+type composePsEntry struct {
+	Service    string `json:"Service"`
+	Name       string `json:"Name"`
+	State      string `json:"State"`
+	Health     string `json:"Health"`
+	Publishers []struct {
+		URL           string `json:"URL"`
+		TargetPort    int    `json:"TargetPort"`
+		PublishedPort int    `json:"PublishedPort"`
+		Protocol      string `json:"Protocol"`
+	} `json:"Publishers"`
+}
+
+// ListContainers returns the project's containers sorted by service. Compose v2
+// prints one JSON object per line while some releases print a single array; both
+// are accepted so the result is the same across docker versions.
+func ListContainers(projectPath string) ([]ContainerStatus, error) {
+	resolvedPath, err := resolveProjectPath(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project path: %w", err)
+	}
+
+	cmd := utils.ComposeCommand("ps", "--all", "--format", "json")
+	cmd.Dir = resolvedPath
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	entries, err := parseComposePs(output)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := make([]ContainerStatus, 0, len(entries))
+	for _, entry := range entries {
+		container := ContainerStatus{
+			Service: entry.Service,
+			Name:    entry.Name,
+			State:   entry.State,
+			Health:  entry.Health,
+			Ports:   []PublishedPort{},
+		}
+		for _, publisher := range entry.Publishers {
+			// Unpublished exposed ports are reported with port 0
+			if publisher.PublishedPort == 0 {
+				continue
+			}
+			container.Ports = append(container.Ports, PublishedPort{
+				HostIP:    publisher.URL,
+				Published: publisher.PublishedPort,
+				Target:    publisher.TargetPort,
+				Protocol:  publisher.Protocol,
+			})
+		}
+		containers = append(containers, container)
+	}
+
+	sort.Slice(containers, func(i, j int) bool {
+		if containers[i].Service != containers[j].Service {
+			return containers[i].Service < containers[j].Service
+		}
+		return containers[i].Name < containers[j].Name
+	})
+	return containers, nil
+}
+
+// parseComposePs decodes either a JSON array or newline-delimited JSON objects
+func parseComposePs(output []byte) ([]composePsEntry, error) {
+	output = bytes.TrimSpace(output)
+	if len(output) == 0 {
+		return nil, nil
+	}
+
+	var entries []composePsEntry
+	if output[0] == '[' {
+		if err := json.Unmarshal(output, &entries); err != nil {
+			return nil, fmt.Errorf("failed to parse docker-compose ps output: %w", err)
+		}
+		return entries, nil
+	}
+
+	for _, line := range strings.Split(string(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var entry composePsEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("failed to parse docker-compose ps output: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
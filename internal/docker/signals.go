@@ -0,0 +1,66 @@
+package docker
+
+import (
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"atempo/internal/ui"
+)
+
+// shutdownGracePeriod is how long docker compose gets to stop after a forwarded
+// signal (or a timeout) before its process group is killed
+const shutdownGracePeriod = 10 * time.Second
+
+// runForwardingSignals runs cmd in its own process group and forwards SIGINT/SIGTERM
+// to that group, so compose can cancel builds and stop containers cleanly instead of
+// being orphaned. A second signal, or the grace period elapsing, kills the group.
+// When compose shares our process group, the terminal has already delivered Ctrl+C
+// to it, so only other signals are forwarded.
+func runForwardingSignals(cmd *exec.Cmd) error {
+	ownGroup := configureProcessGroup(cmd)
+
+	// On timeout (context cancellation) ask for a graceful stop first as well
+	cmd.Cancel = func() error {
+		return signalProcessGroup(cmd, syscall.SIGTERM)
+	}
+	cmd.WaitDelay = shutdownGracePeriod
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case sig := <-signals:
+		ui.Printf("\n→ Received %s, stopping docker compose (up to %s, press Ctrl+C again to force)...\n", sig, shutdownGracePeriod)
+		if ownGroup || sig != os.Interrupt {
+			if err := signalProcessGroup(cmd, sig); err != nil {
+				killProcessGroup(cmd)
+			}
+		}
+
+		select {
+		case <-done:
+		case <-signals:
+			killProcessGroup(cmd)
+			<-done
+		case <-time.After(shutdownGracePeriod):
+			killProcessGroup(cmd)
+			<-done
+		}
+		return ErrInterrupted
+	}
+}
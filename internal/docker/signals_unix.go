@@ -0,0 +1,60 @@
+//go:build !windows
+
+package docker
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+
+	"atempo/internal/ui"
+)
+
+// configureProcessGroup starts cmd in a new process group so signals reach compose
+// and everything it spawned, and a terminal Ctrl+C is delivered only once (by us).
+// A command reading from the terminal stays in the foreground group instead: in a
+// background group its terminal reads and writes would stop it with SIGTTIN/SIGTTOU.
+// It reports whether cmd got its own group.
+func configureProcessGroup(cmd *exec.Cmd) bool {
+	if stdin, ok := cmd.Stdin.(*os.File); ok && ui.IsTerminal(stdin) {
+		return false
+	}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+	return true
+}
+
+// ownsProcessGroup reports whether configureProcessGroup gave cmd its own group
+func ownsProcessGroup(cmd *exec.Cmd) bool {
+	return cmd.SysProcAttr != nil && cmd.SysProcAttr.Setpgid
+}
+
+// signalProcessGroup sends sig to the command's process group, or to the command
+// alone when it shares ours
+func signalProcessGroup(cmd *exec.Cmd, sig os.Signal) error {
+	if cmd.Process == nil {
+		return nil
+	}
+	unixSignal, ok := sig.(syscall.Signal)
+	if !ok {
+		unixSignal = syscall.SIGTERM
+	}
+	if !ownsProcessGroup(cmd) {
+		return cmd.Process.Signal(unixSignal)
+	}
+	return syscall.Kill(-cmd.Process.Pid, unixSignal)
+}
+
+// killProcessGroup forcefully stops the command's process group
+func killProcessGroup(cmd *exec.Cmd) {
+	if cmd.Process == nil {
+		return
+	}
+	if !ownsProcessGroup(cmd) {
+		cmd.Process.Kill()
+		return
+	}
+	syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
@@ -0,0 +1,30 @@
+//go:build windows
+
+package docker
+
+import (
+	"os"
+	"os/exec"
+)
+
+// configureProcessGroup is a no-op on Windows, where the console delivers Ctrl+C
+// to compose directly
+func configureProcessGroup(cmd *exec.Cmd) bool {
+	return false
+}
+
+// signalProcessGroup signals the command's process; Windows cannot deliver an
+// interrupt to another process, so this usually reports an error and the caller kills it
+func signalProcessGroup(cmd *exec.Cmd, sig os.Signal) error {
+	if cmd.Process == nil {
+		return nil
+	}
+	return cmd.Process.Signal(sig)
+}
+
+// killProcessGroup forcefully stops the command's process
+func killProcessGroup(cmd *exec.Cmd) {
+	if cmd.Process != nil {
+		cmd.Process.Kill()
+	}
+}
@@ -0,0 +1,138 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// StaleContainer is an existing container whose name is left over from an earlier
+// docker-compose.yml, or is claimed by another project's containers
+type StaleContainer struct {
+	Name       string
+	WorkingDir string // Compose project directory the container belongs to, if known
+}
+
+// ServiceContainers maps each service in the project's docker-compose.yml to its
+// container_name ("" when compose picks the name). A missing file yields no services.
+func ServiceContainers(projectPath string) (map[string]string, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, "docker-compose.yml"))
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read docker-compose.yml: %w", err)
+	}
+
+	var composeFile struct {
+		Services map[string]struct {
+			ContainerName string `yaml:"container_name"`
+		} `yaml:"services"`
+	}
+	if err := yaml.Unmarshal(data, &composeFile); err != nil {
+		return nil, fmt.Errorf("failed to parse docker-compose.yml: %w", err)
+	}
+
+	services := make(map[string]string, len(composeFile.Services))
+	for name, service := range composeFile.Services {
+		services[name] = service.ContainerName
+	}
+	return services, nil
+}
+
+// RemoveServiceContainers force-removes the containers compose created for the
+// given services of the project, e.g. services deleted from atempo.json. It returns
+// the names of the containers removed.
+func RemoveServiceContainers(projectPath string, services []string) ([]string, error) {
+	var removed, failed []string
+	for _, service := range services {
+		output, err := exec.Command("docker", "ps", "-a", "--format", "{{.Names}}",
+			"--filter", "label=com.docker.compose.project.working_dir="+projectPath,
+			"--filter", "label=com.docker.compose.service="+service).Output()
+		if err != nil {
+			return removed, fmt.Errorf("failed to list containers: %w", err)
+		}
+
+		for _, name := range strings.Fields(string(output)) {
+			fmt.Printf("→ Removing container %s (service '%s')\n", name, service)
+			if err := exec.Command("docker", "rm", "-f", name).Run(); err != nil {
+				failed = append(failed, name)
+				continue
+			}
+			removed = append(removed, name)
+		}
+	}
+
+	if len(failed) > 0 {
+		return removed, fmt.Errorf("failed to remove containers: %v", failed)
+	}
+	return removed, nil
+}
+
+// FindStaleContainers reports containers that will get in the way after a project's
+// container names change (e.g. its name in atempo.json was edited, or it was cloned):
+// leftovers named in previous but no longer in current, and containers already using
+// a current name from another project directory. Docker being unavailable yields none.
+func FindStaleContainers(projectPath string, previous, current []string) (leftovers, conflicts []StaleContainer) {
+	existing, err := containerWorkingDirs()
+	if err != nil {
+		return nil, nil
+	}
+
+	wanted := make(map[string]bool, len(current))
+	for _, name := range current {
+		wanted[name] = true
+		if dir, ok := existing[name]; ok && dir != "" && filepath.Clean(dir) != filepath.Clean(projectPath) {
+			conflicts = append(conflicts, StaleContainer{Name: name, WorkingDir: dir})
+		}
+	}
+
+	for _, name := range previous {
+		if dir, ok := existing[name]; ok && !wanted[name] {
+			leftovers = append(leftovers, StaleContainer{Name: name, WorkingDir: dir})
+		}
+	}
+	return leftovers, conflicts
+}
+
+// RemoveContainers force-removes the given containers
+func RemoveContainers(containers []StaleContainer) error {
+	var failed []string
+	for _, container := range containers {
+		fmt.Printf("→ Removing container %s\n", container.Name)
+		if err := exec.Command("docker", "rm", "-f", container.Name).Run(); err != nil {
+			failed = append(failed, container.Name)
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to remove containers: %v", failed)
+	}
+	return nil
+}
+
+// containerWorkingDirs maps every container name (running or not) to the directory
+// of the compose project that created it
+func containerWorkingDirs() (map[string]string, error) {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return nil, ErrDockerNotInstalled
+	}
+
+	output, err := exec.Command("docker", "ps", "-a", "--format", `{{.Names}}	{{.Label "com.docker.compose.project.working_dir"}}`).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	containers := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		name, dir, _ := strings.Cut(line, "\t")
+		if name != "" {
+			containers[name] = dir
+		}
+	}
+	return containers, nil
+}
@@ -0,0 +1,76 @@
+package docker
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+// ContainerStats is a one-off resource usage sample for one of a project's containers
+type ContainerStats struct {
+	Service   string
+	Container string
+	CPU       string // e.g. "0.52%"
+	Memory    string // Usage and limit, e.g. "84.2MiB / 7.66GiB"
+	MemoryPct string
+	NetIO     string // Received / sent, e.g. "1.2kB / 648B"
+}
+
+// ProjectStats samples the resource usage of the project's running containers with
+// 'docker stats --no-stream', sorted by service. It returns no stats when none run.
+func ProjectStats(projectPath string) ([]ContainerStats, error) {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return nil, ErrDockerNotInstalled
+	}
+
+	// Map the running containers to their compose services by label
+	output, err := exec.Command("docker", "ps", "--format", `{{.Names}}	{{.Label "com.docker.compose.service"}}`,
+		"--filter", "label=com.docker.compose.project.working_dir="+projectPath).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	services := make(map[string]string)
+	var containers []string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		name, service, _ := strings.Cut(line, "\t")
+		if name != "" {
+			services[name] = service
+			containers = append(containers, name)
+		}
+	}
+	if len(containers) == 0 {
+		return nil, nil
+	}
+
+	args := append([]string{"stats", "--no-stream", "--format", "{{.Name}}\t{{.CPUPerc}}\t{{.MemUsage}}\t{{.MemPerc}}\t{{.NetIO}}"}, containers...)
+	output, err = exec.Command("docker", args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read container stats: %w", err)
+	}
+
+	var stats []ContainerStats
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		fields := strings.Split(line, "\t")
+		if len(fields) != 5 {
+			continue
+		}
+		stats = append(stats, ContainerStats{
+			Service:   services[fields[0]],
+			Container: fields[0],
+			CPU:       fields[1],
+			Memory:    fields[2],
+			MemoryPct: fields[3],
+			NetIO:     fields[4],
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Service != stats[j].Service {
+			return stats[i].Service < stats[j].Service
+		}
+		return stats[i].Container < stats[j].Container
+	})
+	return stats, nil
+}
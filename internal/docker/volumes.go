@@ -0,0 +1,63 @@
+package docker
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"atempo/internal/utils"
+)
+
+// ProjectVolume is a named volume declared in the project's compose configuration
+type ProjectVolume struct {
+	Key      string // Name under 'volumes:' in docker-compose.yml
+	Name     string // Actual Docker volume name (project-prefixed unless external)
+	External bool   // Managed outside the project (external: true); never removed by compose
+}
+
+// ProjectVolumes returns the project's named volumes, resolved by compose so the
+// Docker volume names and external flags match what 'down --volumes' would act on
+func ProjectVolumes(projectPath string, composeFiles []string) ([]ProjectVolume, error) {
+	resolvedPath, err := resolveProjectPath(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project path: %w", err)
+	}
+
+	var args []string
+	for _, file := range composeFiles {
+		args = append(args, "-f", file)
+	}
+	if len(composeFiles) == 0 && !utils.FileExists(filepath.Join(resolvedPath, "docker-compose.yml")) {
+		args = append(args, "-f", "infra/docker/docker-compose.yml") // Legacy layout
+	}
+	args = append(args, "config", "--format", "json")
+
+	cmd := utils.ComposeCommand(args...)
+	cmd.Dir = resolvedPath
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compose volumes: %w", err)
+	}
+
+	var config struct {
+		Volumes map[string]struct {
+			Name     string `json:"name"`
+			External bool   `json:"external"`
+		} `json:"volumes"`
+	}
+	if err := json.Unmarshal(output, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse compose config: %w", err)
+	}
+
+	volumes := make([]ProjectVolume, 0, len(config.Volumes))
+	for key, volume := range config.Volumes {
+		name := volume.Name
+		if name == "" {
+			name = key
+		}
+		volumes = append(volumes, ProjectVolume{Key: key, Name: name, External: volume.External})
+	}
+	sort.Slice(volumes, func(i, j int) bool { return volumes[i].Key < volumes[j].Key })
+	return volumes, nil
+}
@@ -0,0 +1,93 @@
+package logger
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Step markers written to setup logs. Every log line has the form
+//
+//	[HH:MM:SS.mmm] <message>
+//
+// and step boundaries use the message form
+//
+//	STEP <MARKER>: <step name>[ (took <duration>)][ - <detail>]
+//
+// so that 'atempo logs' can recognise step status without guessing.
+const (
+	MarkerStarted   = "STARTED"
+	MarkerCompleted = "COMPLETED"
+	MarkerWarning   = "WARNING"
+	MarkerError     = "ERROR"
+)
+
+// LineKind identifies what a parsed log line describes
+type LineKind int
+
+const (
+	LineText LineKind = iota // Header, footer, command output, etc.
+	LineStepStarted
+	LineStepCompleted
+	LineStepWarning
+	LineStepError
+)
+
+// Line is a parsed setup log line
+type Line struct {
+	Raw       string
+	Kind      LineKind
+	Timestamp string // Empty for lines without a timestamp (header/footer)
+	Step      string
+	Duration  string
+	Detail    string
+}
+
+// stepLinePattern matches step marker lines. The optional second timestamp and
+// missing "STEP " prefix keep logs written by older versions readable.
+var stepLinePattern = regexp.MustCompile(`^\[([^\]]+)\] (?:\[[^\]]+\] )?(?:STEP )?(STARTED|COMPLETED|WARNING|ERROR): (.*?)(?: \(took ([^)]+)\))?(?: - (.*))?$`)
+
+// timestampPattern matches the timestamp prefix of a regular log line
+var timestampPattern = regexp.MustCompile(`^\[([^\]]+)\] `)
+
+// formatStepLine builds the message for a step marker line
+func formatStepLine(marker, name string, duration time.Duration, detail string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "STEP %s: %s", marker, name)
+	if marker != MarkerStarted {
+		fmt.Fprintf(&b, " (took %s)", duration.Round(time.Millisecond))
+	}
+	if detail != "" {
+		fmt.Fprintf(&b, " - %s", detail)
+	}
+	return b.String()
+}
+
+// ParseLine parses a single setup log line
+func ParseLine(raw string) Line {
+	line := Line{Raw: raw, Kind: LineText}
+
+	if match := stepLinePattern.FindStringSubmatch(raw); match != nil {
+		line.Timestamp = match[1]
+		line.Step = match[3]
+		line.Duration = match[4]
+		line.Detail = match[5]
+		switch match[2] {
+		case MarkerStarted:
+			line.Kind = LineStepStarted
+		case MarkerCompleted:
+			line.Kind = LineStepCompleted
+		case MarkerWarning:
+			line.Kind = LineStepWarning
+		case MarkerError:
+			line.Kind = LineStepError
+		}
+		return line
+	}
+
+	if match := timestampPattern.FindStringSubmatch(raw); match != nil {
+		line.Timestamp = match[1]
+	}
+	return line
+}
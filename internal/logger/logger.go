@@ -131,7 +131,7 @@ func (l *Logger) StartStep(name string) *Step {
 	}
 	
 	// Write to log file
-	l.logf("[%s] STARTED: %s", step.StartTime.Format("15:04:05"), name)
+	l.logf("%s", formatStepLine(MarkerStarted, name, 0, ""))
 	
 	// Show progress indicator
 	l.showProgress(step)
@@ -145,10 +145,7 @@ func (l *Logger) CompleteStep(step *Step) {
 	step.Duration = time.Since(step.StartTime)
 	
 	// Write to log file
-	l.logf("[%s] COMPLETED: %s (took %s)", 
-		time.Now().Format("15:04:05"), 
-		step.Name, 
-		step.Duration.Round(time.Millisecond))
+	l.logf("%s", formatStepLine(MarkerCompleted, step.Name, step.Duration, ""))
 	
 	// Update progress indicator
 	l.showProgress(step)
@@ -161,11 +158,7 @@ func (l *Logger) WarningStep(step *Step, warning string) {
 	step.Error = fmt.Errorf("warning: %s", warning)
 	
 	// Write to log file
-	l.logf("[%s] WARNING: %s (took %s) - %s", 
-		time.Now().Format("15:04:05"), 
-		step.Name, 
-		step.Duration.Round(time.Millisecond),
-		warning)
+	l.logf("%s", formatStepLine(MarkerWarning, step.Name, step.Duration, warning))
 	
 	// Update progress indicator
 	l.showProgress(step)
@@ -178,11 +171,7 @@ func (l *Logger) ErrorStep(step *Step, err error) {
 	step.Error = err
 	
 	// Write to log file
-	l.logf("[%s] ERROR: %s (took %s) - %s", 
-		time.Now().Format("15:04:05"), 
-		step.Name, 
-		step.Duration.Round(time.Millisecond),
-		err.Error())
+	l.logf("%s", formatStepLine(MarkerError, step.Name, step.Duration, err.Error()))
 	
 	// Update progress indicator
 	l.showProgress(step)
@@ -235,6 +224,22 @@ func (l *Logger) RunCommand(step *Step, cmd *exec.Cmd) error {
 	return nil
 }
 
+// AttachOutput runs a diagnostic command (e.g. container logs after a failure) and
+// appends its combined output to the log under the given label. The command's own
+// failure is logged but not returned, since diagnostics must not mask the original error.
+func (l *Logger) AttachOutput(label string, cmd *exec.Cmd) {
+	l.logf("DIAGNOSTICS: %s (%s)", label, strings.Join(cmd.Args, " "))
+
+	output, err := cmd.CombinedOutput()
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for scanner.Scan() {
+		l.logf("%s: %s", label, scanner.Text())
+	}
+	if err != nil {
+		l.logf("DIAGNOSTICS FAILED: %s", err.Error())
+	}
+}
+
 // captureOutput captures command output and writes it to the log file
 func (l *Logger) captureOutput(reader io.Reader, prefix string, done chan struct{}) {
 	defer func() { done <- struct{}{} }()
@@ -330,6 +335,14 @@ func GetAllLogFiles(projectName string) ([]string, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to find log files: %w", err)
 	}
+
+	// The glob also matches projects sharing the prefix (e.g. "app" and "app_v2")
+	var files []string
+	for _, match := range matches {
+		if parts := logFilePattern.FindStringSubmatch(filepath.Base(match)); parts != nil && parts[1] == projectName {
+			files = append(files, match)
+		}
+	}
 	
-	return matches, nil
+	return files, nil
 }
\ No newline at end of file
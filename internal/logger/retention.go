@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+// logFilePattern matches "<project>_<timestamp>.log" as written by newLogger
+var logFilePattern = regexp.MustCompile(`^(.+)_(\d{4}-\d{2}-\d{2}_\d{2}-\d{2}-\d{2})\.log$`)
+
+// PruneResult summarizes the log files removed by a prune
+type PruneResult struct {
+	Removed int
+	Freed   int64
+}
+
+// PruneLogFiles deletes all but the newest keep log files of a project
+func PruneLogFiles(projectName string, keep int) (PruneResult, error) {
+	files, err := GetAllLogFiles(projectName)
+	if err != nil {
+		return PruneResult{}, err
+	}
+	return removeOldest(files, keep)
+}
+
+// PruneAllLogFiles deletes all but the newest keep log files of every project
+// with logs, including projects no longer in the registry
+func PruneAllLogFiles(keep int) (PruneResult, error) {
+	logsDir, err := logsDirectory()
+	if err != nil {
+		return PruneResult{}, err
+	}
+
+	entries, err := os.ReadDir(logsDir)
+	if os.IsNotExist(err) {
+		return PruneResult{}, nil
+	}
+	if err != nil {
+		return PruneResult{}, fmt.Errorf("failed to read logs directory: %w", err)
+	}
+
+	byProject := make(map[string][]string)
+	for _, entry := range entries {
+		if match := logFilePattern.FindStringSubmatch(entry.Name()); match != nil {
+			byProject[match[1]] = append(byProject[match[1]], filepath.Join(logsDir, entry.Name()))
+		}
+	}
+
+	var total PruneResult
+	for _, files := range byProject {
+		result, err := removeOldest(files, keep)
+		total.Removed += result.Removed
+		total.Freed += result.Freed
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// removeOldest deletes all but the newest keep files. Timestamped names sort
+// chronologically, so the newest files are last.
+func removeOldest(files []string, keep int) (PruneResult, error) {
+	var result PruneResult
+	if keep < 0 {
+		keep = 0
+	}
+	if len(files) <= keep {
+		return result, nil
+	}
+
+	sort.Strings(files)
+	for _, path := range files[:len(files)-keep] {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return result, fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+		result.Removed++
+		result.Freed += info.Size()
+	}
+	return result, nil
+}
+
+// logsDirectory returns ~/.atempo/logs
+func logsDirectory() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".atempo", "logs"), nil
+}
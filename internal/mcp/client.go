@@ -0,0 +1,221 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"sync"
+)
+
+// protocolVersion is the MCP protocol revision sent during the handshake
+const protocolVersion = "2024-11-05"
+
+// MCPClient talks JSON-RPC over stdio to an MCP server process
+type MCPClient struct {
+	serverDir string
+	cmd       *exec.Cmd
+	stdin     io.WriteCloser
+	responses chan rpcResponse
+	stderr    lockedBuffer
+	nextID    int
+	mu        sync.Mutex
+}
+
+// ServerInfo describes the server reported by the initialize handshake
+type ServerInfo struct {
+	Name            string `json:"name"`
+	Version         string `json:"version"`
+	ProtocolVersion string `json:"-"`
+}
+
+// Tool is an MCP tool advertised by the server
+type Tool struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// rpcResponse is a JSON-RPC response (or a server-initiated message, which has no id)
+type rpcResponse struct {
+	ID     *int            `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// lockedBuffer is a bytes.Buffer safe for the process writer and concurrent readers
+type lockedBuffer struct {
+	buf bytes.Buffer
+	mu  sync.Mutex
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// NewMCPClient creates a client for the server installed in serverDir
+// (a project's ai/mcp-server directory)
+func NewMCPClient(serverDir string) *MCPClient {
+	return &MCPClient{serverDir: serverDir}
+}
+
+// Start launches the server with node and begins reading its responses
+func (c *MCPClient) Start() error {
+	if _, err := exec.LookPath("node"); err != nil {
+		return fmt.Errorf("node is not installed or not on PATH")
+	}
+
+	c.cmd = exec.Command("node", filepath.Join(c.serverDir, "index.js"))
+	c.cmd.Dir = c.serverDir
+	c.cmd.Stderr = &c.stderr
+
+	stdin, err := c.cmd.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("failed to create stdin pipe: %w", err)
+	}
+	stdout, err := c.cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("failed to create stdout pipe: %w", err)
+	}
+
+	if err := c.cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start MCP server: %w", err)
+	}
+	c.stdin = stdin
+	c.responses = make(chan rpcResponse, 16)
+
+	go c.readResponses(stdout)
+	return nil
+}
+
+// Initialize performs the MCP handshake and returns the server's identity
+func (c *MCPClient) Initialize(ctx context.Context) (*ServerInfo, error) {
+	params := map[string]interface{}{
+		"protocolVersion": protocolVersion,
+		"capabilities":    map[string]interface{}{},
+		"clientInfo":      map[string]string{"name": "atempo", "version": "1.0.0"},
+	}
+
+	var result struct {
+		ProtocolVersion string     `json:"protocolVersion"`
+		ServerInfo      ServerInfo `json:"serverInfo"`
+	}
+	if err := c.call(ctx, "initialize", params, &result); err != nil {
+		return nil, err
+	}
+
+	if err := c.notify("notifications/initialized"); err != nil {
+		return nil, err
+	}
+
+	info := result.ServerInfo
+	info.ProtocolVersion = result.ProtocolVersion
+	return &info, nil
+}
+
+// ListTools returns the tools the server advertises
+func (c *MCPClient) ListTools(ctx context.Context) ([]Tool, error) {
+	var result struct {
+		Tools []Tool `json:"tools"`
+	}
+	if err := c.call(ctx, "tools/list", map[string]interface{}{}, &result); err != nil {
+		return nil, err
+	}
+	return result.Tools, nil
+}
+
+// Stderr returns what the server has written to stderr so far
+func (c *MCPClient) Stderr() string {
+	return c.stderr.String()
+}
+
+// Close stops the server process
+func (c *MCPClient) Close() error {
+	if c.cmd == nil || c.cmd.Process == nil {
+		return nil
+	}
+	c.stdin.Close()
+	c.cmd.Process.Kill()
+	c.cmd.Wait()
+	return nil
+}
+
+// call sends a request and waits for its response or for ctx to expire
+func (c *MCPClient) call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	c.mu.Lock()
+	c.nextID++
+	id := c.nextID
+	c.mu.Unlock()
+
+	if err := c.send(map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": params}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s response", method)
+		case response, ok := <-c.responses:
+			if !ok {
+				return fmt.Errorf("MCP server exited before answering %s", method)
+			}
+			// Skip notifications and responses to other requests
+			if response.ID == nil || *response.ID != id {
+				continue
+			}
+			if response.Error != nil {
+				return fmt.Errorf("%s failed: %s (code %d)", method, response.Error.Message, response.Error.Code)
+			}
+			if err := json.Unmarshal(response.Result, result); err != nil {
+				return fmt.Errorf("failed to parse %s response: %w", method, err)
+			}
+			return nil
+		}
+	}
+}
+
+// notify sends a JSON-RPC notification
+func (c *MCPClient) notify(method string) error {
+	return c.send(map[string]interface{}{"jsonrpc": "2.0", "method": method})
+}
+
+// send writes one newline-delimited JSON-RPC message to the server
+func (c *MCPClient) send(message interface{}) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+	if _, err := c.stdin.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write to MCP server: %w", err)
+	}
+	return nil
+}
+
+// readResponses decodes server output until it closes, ignoring non-JSON lines
+func (c *MCPClient) readResponses(stdout io.Reader) {
+	defer close(c.responses)
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var response rpcResponse
+		if err := json.Unmarshal(scanner.Bytes(), &response); err != nil {
+			continue
+		}
+		c.responses <- response
+	}
+}
@@ -9,6 +9,14 @@ import (
 	"time"
 )
 
+// AIContextDir is the project directory that holds the AI context files and the MCP server
+const AIContextDir = "ai"
+
+// ServerDir returns the directory a project's MCP server is installed in
+func ServerDir(projectDir string) string {
+	return filepath.Join(projectDir, AIContextDir, "mcp-server")
+}
+
 // MCPServer represents an MCP server configuration
 type MCPServer struct {
 	Name        string            `json:"name"`
@@ -291,7 +299,7 @@ func generateUniversalServer(framework string) *MCPServer {
 
 // InstallMCPServer installs the selected MCP server
 func InstallMCPServer(server MCPServer, projectDir string) error {
-	mcpDir := filepath.Join(projectDir, "ai", "mcp-server")
+	mcpDir := ServerDir(projectDir)
 	
 	switch server.Type {
 	case "official", "community":
@@ -0,0 +1,60 @@
+package registry
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A container that restarted at least crashLoopRestarts times and was (re)started
+// within crashLoopWindow, or is restarting right now, is reported as crash-looping
+const (
+	crashLoopRestarts = 3
+	crashLoopWindow   = 5 * time.Minute
+)
+
+// restartInfo is the restart state of one container from 'docker inspect'
+type restartInfo struct {
+	Count      int
+	Restarting bool
+	StartedAt  time.Time
+}
+
+// crashLooping reports whether a container keeps dying and being restarted
+func (info restartInfo) crashLooping(now time.Time) bool {
+	if info.Count < crashLoopRestarts {
+		return false
+	}
+	return info.Restarting || now.Sub(info.StartedAt) < crashLoopWindow
+}
+
+// inspectRestarts returns the restart state of the named containers in one docker
+// call. Containers that cannot be inspected are left out.
+func inspectRestarts(containers []string) map[string]restartInfo {
+	results := make(map[string]restartInfo)
+	if len(containers) == 0 {
+		return results
+	}
+
+	args := append([]string{"inspect", "--format", "{{.Name}} {{.RestartCount}} {{.State.Restarting}} {{.State.StartedAt}}"}, containers...)
+	output, _ := exec.Command("docker", args...).Output() // Partial output is still useful
+
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			continue
+		}
+		count, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		startedAt, _ := time.Parse(time.RFC3339Nano, fields[3])
+		results[strings.TrimPrefix(fields[0], "/")] = restartInfo{
+			Count:      count,
+			Restarting: fields[2] == "true",
+			StartedAt:  startedAt,
+		}
+	}
+	return results
+}
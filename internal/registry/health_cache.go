@@ -0,0 +1,117 @@
+package registry
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// HealthCacheTTL is how long a project's health check result is reused
+const HealthCacheTTL = 5 * time.Second
+
+// healthResult is a cached checkProjectHealth result
+type healthResult struct {
+	Status    string    `json:"status"`
+	Services  []Service `json:"services"`
+	Ports     []Port    `json:"ports"`
+	URLs      []string  `json:"urls"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Health cache shared by a single CLI invocation, keyed by project path
+var (
+	healthCache     = make(map[string]healthResult)
+	healthCacheDisk bool
+	healthCacheMu   sync.Mutex
+)
+
+// EnableDiskHealthCache also persists health results to ~/.atempo/health-cache.json,
+// so repeatedly rendered dashboards share results across invocations within the TTL
+func EnableDiskHealthCache() {
+	healthCacheMu.Lock()
+	defer healthCacheMu.Unlock()
+
+	healthCacheDisk = true
+	for path, result := range loadDiskHealthCache() {
+		if existing, ok := healthCache[path]; !ok || result.CheckedAt.After(existing.CheckedAt) {
+			healthCache[path] = result
+		}
+	}
+}
+
+// cachedProjectHealth returns the project's health, reusing a result younger than HealthCacheTTL
+func (r *Registry) cachedProjectHealth(projectPath string) (string, []Service, []Port, []string) {
+	healthCacheMu.Lock()
+	result, ok := healthCache[projectPath]
+	healthCacheMu.Unlock()
+
+	if ok && time.Since(result.CheckedAt) < HealthCacheTTL {
+		return result.Status, result.Services, result.Ports, result.URLs
+	}
+
+	status, services, ports, urls := r.checkProjectHealth(projectPath)
+
+	healthCacheMu.Lock()
+	healthCache[projectPath] = healthResult{
+		Status:    status,
+		Services:  services,
+		Ports:     ports,
+		URLs:      urls,
+		CheckedAt: time.Now(),
+	}
+	if healthCacheDisk {
+		saveDiskHealthCache(healthCache)
+	}
+	healthCacheMu.Unlock()
+
+	return status, services, ports, urls
+}
+
+// healthCachePath returns the on-disk health cache location
+func healthCachePath() (string, error) {
+	registryPath, err := GetRegistryPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(registryPath), "health-cache.json"), nil
+}
+
+// loadDiskHealthCache reads unexpired results from the on-disk cache
+func loadDiskHealthCache() map[string]healthResult {
+	results := make(map[string]healthResult)
+
+	path, err := healthCachePath()
+	if err != nil {
+		return results
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return results
+	}
+
+	var stored map[string]healthResult
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return results
+	}
+	for projectPath, result := range stored {
+		if time.Since(result.CheckedAt) < HealthCacheTTL {
+			results[projectPath] = result
+		}
+	}
+	return results
+}
+
+// saveDiskHealthCache writes the cache to disk; failures only cost a cache miss
+func saveDiskHealthCache(results map[string]healthResult) {
+	path, err := healthCachePath()
+	if err != nil {
+		return
+	}
+	data, err := json.Marshal(results)
+	if err != nil {
+		return
+	}
+	os.WriteFile(path, data, 0644)
+}
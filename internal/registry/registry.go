@@ -2,16 +2,22 @@ package registry
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
+	"atempo/internal/compose"
 	"atempo/internal/utils"
 )
 
+// ErrProjectNotFound is returned when no registered project matches a name or alias
+var ErrProjectNotFound = errors.New("project not found in registry")
+
 // Project represents a registered Atempo project
 type Project struct {
 	Name         string    `json:"name"`
@@ -28,6 +34,20 @@ type Project struct {
 	GitBranch    string    `json:"git_branch,omitempty"`
 	GitStatus    string    `json:"git_status,omitempty"`
 	Services     []Service `json:"services"`
+	Aliases      []string  `json:"aliases,omitempty"` // Alternate names accepted wherever the name is
+}
+
+// Matches reports whether name is the project's name or one of its aliases
+func (p *Project) Matches(name string) bool {
+	if p.Name == name {
+		return true
+	}
+	for _, alias := range p.Aliases {
+		if alias == name {
+			return true
+		}
+	}
+	return false
 }
 
 // Port represents a port mapping for a service
@@ -41,8 +61,9 @@ type Port struct {
 // Service represents a Docker service with its status
 type Service struct {
 	Name    string `json:"name"`
-	Status  string `json:"status"`  // running/stopped/healthy/unhealthy
+	Status  string `json:"status"`  // running/stopped/healthy/unhealthy/crash-looping/completed
 	URL     string `json:"url,omitempty"`
+	Restarts int   `json:"restarts,omitempty"` // Restarts since the container was created
 }
 
 // Registry manages the mapping of project names to paths
@@ -128,9 +149,13 @@ func (r *Registry) AddProject(name, path, framework, version string) error {
 				Version:      version,
 				CreatedAt:    project.CreatedAt,
 				LastAccessed: time.Now(),
+				Aliases:      project.Aliases,
 			}
 			return r.SaveRegistry()
 		}
+		if project.Matches(name) {
+			return fmt.Errorf("'%s' is already an alias of project '%s'", name, project.Name)
+		}
 	}
 
 	// Add new project
@@ -147,10 +172,10 @@ func (r *Registry) AddProject(name, path, framework, version string) error {
 	return r.SaveRegistry()
 }
 
-// FindProject finds a project by name
+// FindProject finds a project by name or alias
 func (r *Registry) FindProject(name string) (*Project, error) {
 	for i, project := range r.Projects {
-		if project.Name == name {
+		if project.Matches(name) {
 			// Update last accessed time
 			r.Projects[i].LastAccessed = time.Now()
 			r.SaveRegistry() // Save updated access time
@@ -158,7 +183,7 @@ func (r *Registry) FindProject(name string) (*Project, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("project '%s' not found in registry", name)
+	return nil, fmt.Errorf("%w: '%s'", ErrProjectNotFound, name)
 }
 
 // ListProjects returns all registered projects
@@ -169,13 +194,51 @@ func (r *Registry) ListProjects() []Project {
 // RemoveProject removes a project from the registry
 func (r *Registry) RemoveProject(name string) error {
 	for i, project := range r.Projects {
-		if project.Name == name {
+		if project.Matches(name) {
 			r.Projects = append(r.Projects[:i], r.Projects[i+1:]...)
 			return r.SaveRegistry()
 		}
 	}
 
-	return fmt.Errorf("project '%s' not found in registry", name)
+	return fmt.Errorf("%w: '%s'", ErrProjectNotFound, name)
+}
+
+// AddAlias adds an alternate name for a project. Aliases must be unique across all
+// project names and aliases so resolution is never ambiguous.
+func (r *Registry) AddAlias(name, alias string) error {
+	project, err := r.FindProject(name)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range r.Projects {
+		if other.Matches(alias) {
+			if other.Name == project.Name {
+				return fmt.Errorf("'%s' already refers to project '%s'", alias, project.Name)
+			}
+			return fmt.Errorf("'%s' is already used by project '%s'", alias, other.Name)
+		}
+	}
+
+	project.Aliases = append(project.Aliases, alias)
+	return r.SaveRegistry()
+}
+
+// RemoveAlias removes an alternate name from a project
+func (r *Registry) RemoveAlias(name, alias string) error {
+	project, err := r.FindProject(name)
+	if err != nil {
+		return err
+	}
+
+	for i, existing := range project.Aliases {
+		if existing == alias {
+			project.Aliases = append(project.Aliases[:i], project.Aliases[i+1:]...)
+			return r.SaveRegistry()
+		}
+	}
+
+	return fmt.Errorf("project '%s' has no alias '%s'", project.Name, alias)
 }
 
 // ResolveProjectPath resolves a project identifier to an absolute path
@@ -184,9 +247,9 @@ func (r *Registry) RemoveProject(name string) error {
 // - A relative path
 // - An absolute path
 func ResolveProjectPath(identifier string) (string, error) {
-	// If empty, use current directory
+	// If empty, use the project containing the current directory
 	if identifier == "" {
-		return os.Getwd()
+		return utils.CurrentProjectDir()
 	}
 
 	// Try to find by project name first
@@ -281,9 +344,9 @@ func (r *Registry) CleanupInvalidProjects() error {
 // UpdateProjectStatus updates the status and health information for a project
 func (r *Registry) UpdateProjectStatus(name string) error {
 	for i, project := range r.Projects {
-		if project.Name == name {
+		if project.Matches(name) {
 			// Check project status
-			status, services, ports, urls := r.checkProjectHealth(project.Path)
+			status, services, ports, urls := r.cachedProjectHealth(project.Path)
 			
 			r.Projects[i].Status = status
 			r.Projects[i].Services = services
@@ -300,13 +363,14 @@ func (r *Registry) UpdateProjectStatus(name string) error {
 		}
 	}
 	
-	return fmt.Errorf("project '%s' not found", name)
+	return fmt.Errorf("%w: '%s'", ErrProjectNotFound, name)
 }
 
-// UpdateAllProjectsStatus updates status for all registered projects
+// UpdateAllProjectsStatus updates status for all registered projects. Health results
+// younger than HealthCacheTTL are reused instead of querying docker again.
 func (r *Registry) UpdateAllProjectsStatus() error {
 	for i := range r.Projects {
-		status, services, ports, urls := r.checkProjectHealth(r.Projects[i].Path)
+		status, services, ports, urls := r.cachedProjectHealth(r.Projects[i].Path)
 		
 		r.Projects[i].Status = status
 		r.Projects[i].Services = services
@@ -336,7 +400,7 @@ func (r *Registry) checkProjectHealth(projectPath string) (string, []Service, []
 	}
 
 	// Run docker-compose ps to get service status
-	cmd := exec.Command("docker-compose", "ps", "--format", "json")
+	cmd := utils.ComposeCommand("ps", "--format", "json")
 	cmd.Dir = projectPath
 	output, err := cmd.Output()
 	if err != nil {
@@ -347,6 +411,16 @@ func (r *Registry) checkProjectHealth(projectPath string) (string, []Service, []
 	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
 	runningServices := 0
 	totalServices := 0
+	containers := make(map[string]int) // container name -> index in services
+	var containerNames []string
+
+	// One-shot services are done, not stopped, once they exit successfully
+	oneshot := make(map[string]bool)
+	if config, err := compose.LoadAtempoConfig(projectPath); err == nil {
+		for name, service := range config.Services {
+			oneshot[name] = service.Oneshot
+		}
+	}
 
 	for _, line := range lines {
 		if strings.TrimSpace(line) == "" {
@@ -362,13 +436,22 @@ func (r *Registry) checkProjectHealth(projectPath string) (string, []Service, []
 		serviceName := serviceData["Service"].(string)
 		state := serviceData["State"].(string)
 		
-		// Determine service status
+		// Determine service status, refined by the container healthcheck when it has one
+		health, _ := serviceData["Health"].(string)
 		var serviceStatus string
-		switch state {
-		case "running":
+		switch {
+		case state == "running" && health == "healthy":
+			serviceStatus = "healthy"
+			runningServices++
+		case state == "running" && (health == "starting" || health == "unhealthy"):
+			serviceStatus = health
+		case state == "running":
 			serviceStatus = "running"
 			runningServices++
-		case "exited":
+		case state == "exited" && oneshot[serviceName] && exitCode(serviceData) == 0:
+			serviceStatus = "completed"
+			runningServices++
+		case state == "exited":
 			serviceStatus = "stopped"
 		default:
 			serviceStatus = "unhealthy"
@@ -378,9 +461,13 @@ func (r *Registry) checkProjectHealth(projectPath string) (string, []Service, []
 			Name:   serviceName,
 			Status: serviceStatus,
 		})
+		if containerName, ok := serviceData["Name"].(string); ok {
+			containers[containerName] = len(services) - 1
+			containerNames = append(containerNames, containerName)
+		}
 
 		// Extract port information if service is running
-		if serviceStatus == "running" && serviceData["Publishers"] != nil {
+		if state == "running" && serviceData["Publishers"] != nil {
 			if publishers, ok := serviceData["Publishers"].([]interface{}); ok {
 				for _, pub := range publishers {
 					if pubMap, ok := pub.(map[string]interface{}); ok {
@@ -413,6 +500,19 @@ func (r *Registry) checkProjectHealth(projectPath string) (string, []Service, []
 		}
 	}
 
+	// A "running" container that keeps restarting is not really up
+	now := time.Now()
+	for containerName, info := range inspectRestarts(containerNames) {
+		service := &services[containers[containerName]]
+		service.Restarts = info.Count
+		if info.crashLooping(now) {
+			if service.Status == "running" || service.Status == "healthy" {
+				runningServices--
+			}
+			service.Status = "crash-looping"
+		}
+	}
+
 	// Determine overall status
 	if totalServices == 0 {
 		overallStatus = "no-services"
@@ -427,6 +527,69 @@ func (r *Registry) checkProjectHealth(projectPath string) (string, []Service, []
 	return overallStatus, services, ports, urls
 }
 
+// exitCode returns the ExitCode of a 'compose ps' JSON entry, or -1 when absent
+func exitCode(serviceData map[string]interface{}) int {
+	if code, ok := serviceData["ExitCode"].(float64); ok {
+		return int(code)
+	}
+	return -1
+}
+
+// CheckProjectHealth queries docker for a project's overall status and per-service
+// states, bypassing the health cache
+func CheckProjectHealth(projectPath string) (string, []Service) {
+	status, services, _, _ := (&Registry{}).checkProjectHealth(projectPath)
+	return status, services
+}
+
+// InspectProject fills in live status, services, ports, URLs and Git details for a
+// project that need not be registered, bypassing the health cache
+func InspectProject(project *Project) {
+	r := &Registry{}
+	project.Status, project.Services, project.Ports, project.URLs = r.checkProjectHealth(project.Path)
+	project.GitBranch, project.GitStatus = r.getGitInfo(project.Path)
+}
+
+// ConfiguredPorts returns the host port mappings and web URLs declared in a project's
+// atempo.json. It is used as a fallback when services are stopped and live docker
+// inspection reports nothing, so users can still see their intended ports.
+func ConfiguredPorts(projectPath string) ([]Port, []string, error) {
+	config, err := compose.LoadAtempoConfig(projectPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceNames := make([]string, 0, len(config.Services))
+	for name := range config.Services {
+		serviceNames = append(serviceNames, name)
+	}
+	sort.Strings(serviceNames)
+
+	var ports []Port
+	var urls []string
+	for _, serviceName := range serviceNames {
+		for _, spec := range config.Services[serviceName].Ports {
+			mapping, ok := compose.ParsePortMapping(spec)
+			if !ok {
+				continue
+			}
+
+			ports = append(ports, Port{
+				Service:  serviceName,
+				Internal: mapping.ContainerPort,
+				External: mapping.HostPort,
+				Protocol: mapping.Protocol,
+			})
+
+			if isWebPort(mapping.HostPort) {
+				urls = append(urls, fmt.Sprintf("http://localhost:%d", mapping.HostPort))
+			}
+		}
+	}
+
+	return ports, urls, nil
+}
+
 // getGitInfo retrieves Git branch and status information
 func (r *Registry) getGitInfo(projectPath string) (string, string) {
 	// Check if it's a Git repository
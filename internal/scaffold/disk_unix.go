@@ -0,0 +1,16 @@
+//go:build !windows
+
+package scaffold
+
+import (
+	"syscall"
+)
+
+// freeDiskSpace returns the bytes available to the current user on the filesystem containing path
+func freeDiskSpace(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
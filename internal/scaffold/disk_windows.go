@@ -0,0 +1,12 @@
+//go:build windows
+
+package scaffold
+
+import (
+	"errors"
+)
+
+// freeDiskSpace is not implemented on Windows; the disk space check is skipped
+func freeDiskSpace(path string) (uint64, error) {
+	return 0, errors.New("disk space check not supported on windows")
+}
@@ -0,0 +1,87 @@
+package scaffold
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"atempo/internal/utils"
+)
+
+// Minimum free disk space required before scaffolding. Docker installers pull
+// framework and service images, so they need considerably more room.
+const (
+	minFreeBytes       = 500 << 20 // 500 MB
+	minFreeBytesDocker = 2 << 30   // 2 GB
+)
+
+// installerTools lists the executables each installer type depends on
+var installerTools = map[string][]string{
+	"docker":   {"docker"},
+	"composer": {"composer"},
+	"npm":      {"npm"},
+	"python":   {"python3"},
+	"pip":      {"python3"},
+}
+
+// preflightCheck verifies that the installer's tooling is present and that there is
+// enough free disk space, so scaffolding fails fast instead of leaving a
+// half-created project behind
+func preflightCheck(meta Metadata, projectDir string) error {
+	var missing []string
+	for _, tool := range requiredTools(meta) {
+		if _, err := exec.LookPath(tool); err != nil {
+			missing = append(missing, tool)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("required tools not found in PATH: %s (install them and try again)", strings.Join(missing, ", "))
+	}
+
+	if requiresDocker(meta) {
+		if err := checkDockerAvailability(); err != nil {
+			return fmt.Errorf("Docker is required but not available: %w", err)
+		}
+	}
+
+	required := uint64(minFreeBytes)
+	if requiresDocker(meta) {
+		required = minFreeBytesDocker
+	}
+	free, err := freeDiskSpace(projectDir)
+	if err != nil {
+		// Disk space can't be determined on every platform; don't block on it
+		return nil
+	}
+	if free < required {
+		return fmt.Errorf("not enough free disk space in %s: %s available, at least %s required", projectDir, utils.FormatBytes(free), utils.FormatBytes(required))
+	}
+
+	return nil
+}
+
+// requiredTools returns the executables needed by the installer, including the
+// installer command itself
+func requiredTools(meta Metadata) []string {
+	seen := make(map[string]bool)
+	var tools []string
+	add := func(tool string) {
+		if tool != "" && !seen[tool] {
+			seen[tool] = true
+			tools = append(tools, tool)
+		}
+	}
+
+	for _, tool := range installerTools[meta.Installer.Type] {
+		add(tool)
+	}
+	if len(meta.Installer.Command) > 0 && !strings.Contains(meta.Installer.Command[0], "{{") {
+		add(meta.Installer.Command[0])
+	}
+	return tools
+}
+
+// requiresDocker reports whether the installer runs through Docker
+func requiresDocker(meta Metadata) bool {
+	return meta.Installer.Type == "docker" || (len(meta.Installer.Command) > 0 && meta.Installer.Command[0] == "docker")
+}
@@ -0,0 +1,112 @@
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"atempo/internal/registry"
+)
+
+// rollbackTracker records what existed before scaffolding so a failed run can
+// remove exactly what it created
+type rollbackTracker struct {
+	projectDir    string
+	projectName   string
+	existing      map[string]bool
+	wasRegistered bool
+}
+
+// newRollbackTracker snapshots the project directory and registry
+func newRollbackTracker(projectDir, projectName string) *rollbackTracker {
+	tracker := &rollbackTracker{
+		projectDir:  projectDir,
+		projectName: projectName,
+		existing:    make(map[string]bool),
+	}
+
+	if entries, err := os.ReadDir(projectDir); err == nil {
+		for _, entry := range entries {
+			tracker.existing[entry.Name()] = true
+		}
+	}
+
+	if reg, err := registry.LoadRegistry(); err == nil {
+		if _, err := reg.FindProject(projectName); err == nil {
+			tracker.wasRegistered = true
+		}
+	}
+
+	return tracker
+}
+
+// created returns the top-level paths created since the snapshot
+func (t *rollbackTracker) created() []string {
+	var paths []string
+	entries, err := os.ReadDir(t.projectDir)
+	if err != nil {
+		return paths
+	}
+	for _, entry := range entries {
+		if !t.existing[entry.Name()] {
+			paths = append(paths, filepath.Join(t.projectDir, entry.Name()))
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// handleFailure rolls back a failed scaffold automatically with CleanOnFail, or after
+// confirmation through opts.ConfirmRollback
+func (t *rollbackTracker) handleFailure(opts Options) {
+	created := t.created()
+	if len(created) == 0 && !t.newlyRegistered() {
+		return
+	}
+
+	if !opts.CleanOnFail && (opts.ConfirmRollback == nil || !opts.ConfirmRollback(created)) {
+		fmt.Printf("💡 Partially created files were left in %s (use --clean-on-fail to remove them automatically)\n", t.projectDir)
+		return
+	}
+
+	if err := t.rollback(created); err != nil {
+		fmt.Printf("⚠️  Rollback incomplete: %v\n", err)
+		return
+	}
+	fmt.Printf("↩️  Rolled back %d created path(s) in %s\n", len(created), t.projectDir)
+}
+
+// newlyRegistered reports whether the project was added to the registry during this run
+func (t *rollbackTracker) newlyRegistered() bool {
+	if t.wasRegistered {
+		return false
+	}
+	reg, err := registry.LoadRegistry()
+	if err != nil {
+		return false
+	}
+	project, err := reg.FindProject(t.projectName)
+	return err == nil && project.Path == t.projectDir
+}
+
+// rollback removes the created paths and a registry entry added during this run
+func (t *rollbackTracker) rollback(created []string) error {
+	for _, path := range created {
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+	}
+
+	if t.newlyRegistered() {
+		reg, err := registry.LoadRegistry()
+		if err != nil {
+			return fmt.Errorf("failed to load registry: %w", err)
+		}
+		if err := reg.RemoveProject(t.projectName); err != nil {
+			return fmt.Errorf("failed to remove project from registry: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -8,9 +8,14 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"atempo/internal/compose"
+	"atempo/internal/config"
+	"atempo/internal/docker"
 	"atempo/internal/logger"
 	"atempo/internal/mcp"
 	"atempo/internal/registry"
@@ -34,15 +39,89 @@ type Metadata struct {
 	Installer  Installer `json:"installer"`   // How to scaffold the source code
 	WorkingDir string    `json:"working-dir"` // Expected project root path in container, e.g., /var/www
 	MinVersion string    `json:"min-version"` // Minimum supported version (semantic)
+	MaxVersion string    `json:"max-version"` // Highest supported major version, e.g. "12.x"
+}
+
+// Options customizes a scaffold run
+type Options struct {
+	Name         string // Project name override (defaults to the directory basename)
+	FromTemplate string // Path to an atempo.json skeleton whose services replace the framework defaults
+	CleanOnFail  bool   // Remove files and registry entries created by a failed run without asking
+	Seed         bool   // Seed the database after the initial migrations (Laravel)
+	NoInstall    bool   // Skip the framework installer and post-install setup; only scaffold Atempo files
+	NoRegister   bool   // Leave the project out of the registry (throwaway projects); compose is still generated
+
+	// Vars are extra {{key}} substitutions for template files, from --set key=value
+	Vars map[string]string
+
+	// Only and Skip select steps by label (see Steps); Only wins when both are set
+	Only []string
+	Skip []string
+
+	// ConfirmRollback is asked whether to remove the paths created by a failed run
+	// when CleanOnFail is not set. When nil, created files are left in place.
+	ConfirmRollback func(created []string) bool
+}
+
+// projectNamePattern matches DNS-safe slugs usable in domains and container names
+var projectNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// templateVariablePattern matches names usable as {{key}} template variables
+var templateVariablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// builtinTemplateVariables are substituted by the scaffolder itself and cannot be overridden
+var builtinTemplateVariables = []string{"project", "name", "cwd", "version"}
+
+// ValidateTemplateVariable checks that a custom template variable name is usable
+// as {{name}} and does not shadow a built-in variable
+func ValidateTemplateVariable(name string) error {
+	if !templateVariablePattern.MatchString(name) {
+		return fmt.Errorf("invalid template variable '%s': use letters, digits and underscores, starting with a letter or underscore", name)
+	}
+	for _, builtin := range builtinTemplateVariables {
+		if name == builtin {
+			return fmt.Errorf("template variable '%s' is built in and cannot be set", name)
+		}
+	}
+	return nil
+}
+
+// ValidateProjectName checks that a project name is a DNS-safe slug, since it
+// becomes part of container names, image names and local domains
+func ValidateProjectName(name string) error {
+	if !projectNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid project name '%s': use lowercase letters, digits and hyphens (max 63 chars, must start and end with a letter or digit)", name)
+	}
+	return nil
 }
 
 // Run executes the scaffolding process for the given framework and version.
 // It loads the template's `atempo.json`, performs template substitution,
 // runs the specified install command, and copies template files.
-func Run(framework string, version string, templatesFS, mcpServersFS embed.FS) error {
+func Run(framework string, version string, templatesFS, mcpServersFS embed.FS, opts Options) (err error) {
+	if version == "" {
+		if version, err = DefaultVersion(templatesFS, framework); err != nil {
+			return err
+		}
+	}
+
 	// Get the current working directory (user's target project root)
 	projectDir, _ := os.Getwd()
 	projectName := filepath.Base(projectDir)
+	if opts.Name != "" {
+		if err := ValidateProjectName(opts.Name); err != nil {
+			return err
+		}
+		projectName = opts.Name
+	}
+
+	// Track what this run creates so a fatal error can be rolled back
+	rollback := newRollbackTracker(projectDir, projectName)
+	defer func() {
+		if err != nil {
+			rollback.handleFailure(opts)
+		}
+	}()
 
 	// Create quiet logger for this project (progress shown by caller)
 	log, err := logger.NewQuiet(projectName)
@@ -55,24 +134,20 @@ func Run(framework string, version string, templatesFS, mcpServersFS embed.FS) e
 
 	// Step 1: Load and validate template configuration
 	loadStep := log.StartStep("Loading template configuration")
-	// Load atempo.json (try embedded first, fallback to filesystem)
-	var metaBytes []byte
-
-	// Try embedded first
-	embeddedPath := fmt.Sprintf("templates/frameworks/%s/atempo.json", framework)
-	metaBytes, readErr := templatesFS.ReadFile(embeddedPath)
+	metaBytes, readErr := readTemplateMetadata(templatesFS, framework)
 	if readErr != nil {
-		// Fallback to filesystem - find templates relative to binary location
-		filesystemPath, pathErr := getFilesystemTemplatePath(framework, "atempo.json")
-		if pathErr != nil {
-			log.ErrorStep(loadStep, fmt.Errorf("could not locate atempo.json for %s: %w", framework, pathErr))
-			return fmt.Errorf("could not locate atempo.json for %s: %w", framework, pathErr)
-		}
-		metaBytes, readErr = os.ReadFile(filesystemPath)
-		if readErr != nil {
-			log.ErrorStep(loadStep, fmt.Errorf("could not read atempo.json for %s: %w", framework, readErr))
-			return fmt.Errorf("could not read atempo.json for %s: %w", framework, readErr)
+		log.ErrorStep(loadStep, readErr)
+		return readErr
+	}
+
+	// Merge a user-provided stack template over the framework defaults
+	if opts.FromTemplate != "" {
+		merged, mergeErr := mergeStackTemplate(metaBytes, opts.FromTemplate)
+		if mergeErr != nil {
+			log.ErrorStep(loadStep, mergeErr)
+			return mergeErr
 		}
+		metaBytes = merged
 	}
 
 	// Parse the metadata JSON into a structured object
@@ -88,35 +163,71 @@ func Run(framework string, version string, templatesFS, mcpServersFS embed.FS) e
 		return fmt.Errorf("version validation failed: %w", validateErr)
 	}
 
+	// Fail fast on missing tooling or low disk space before anything is written.
+	// Without the installer there is nothing to check: only templates are copied.
+	if opts.runs(StepInstall) {
+		if preflightErr := preflightCheck(meta, projectDir); preflightErr != nil {
+			log.ErrorStep(loadStep, fmt.Errorf("preflight check failed: %w", preflightErr))
+			return fmt.Errorf("preflight check failed: %w", preflightErr)
+		}
+	}
+
 	log.CompleteStep(loadStep)
 
 	// Step 2: Run the framework installer (e.g., composer create-project)
 	installStep := log.StartStep(fmt.Sprintf("Installing %s %s application", framework, version))
-	if err := runInstaller(log, installStep, meta, projectDir, projectName, version); err != nil {
-		log.ErrorStep(installStep, err)
-		return fmt.Errorf("installer failed: %w", err)
+	if opts.NoInstall {
+		log.WarningStep(installStep, "Installer skipped (--no-install) - add the application source to src/ before starting the project")
+	} else if !opts.runs(StepInstall) {
+		log.WarningStep(installStep, "Installer skipped (step deselected)")
+	} else {
+		if err := runInstaller(log, installStep, meta, projectDir, projectName, version, opts.Vars); err != nil {
+			log.ErrorStep(installStep, err)
+			return fmt.Errorf("installer failed: %w", err)
+		}
+		log.CompleteStep(installStep)
 	}
-	log.CompleteStep(installStep)
 
 	// Step 3: Copy template files (AI context, Docker setup, etc.)
 	copyStep := log.StartStep("Copying template files")
-	if err := copyTemplateFiles(log, copyStep, projectDir, projectName, meta.Framework, version, templatesFS, mcpServersFS); err != nil {
-		log.ErrorStep(copyStep, err)
-		return fmt.Errorf("failed to copy template files: %w", err)
+	if !opts.runs(StepTemplates) {
+		log.WarningStep(copyStep, "Template copy skipped (step deselected)")
+	} else {
+		for _, conflict := range aiDirConflicts(projectDir, rollback.existing) {
+			log.WarningStep(copyStep, conflict)
+		}
+		if err := copyTemplateFiles(log, copyStep, projectDir, projectName, meta.Framework, version, opts.Vars, templatesFS, mcpServersFS); err != nil {
+			log.ErrorStep(copyStep, err)
+			return fmt.Errorf("failed to copy template files: %w", err)
+		}
+		if opts.FromTemplate != "" {
+			if err := writeProjectConfig(projectDir, projectName, version, opts.Vars, metaBytes); err != nil {
+				log.ErrorStep(copyStep, err)
+				return err
+			}
+		}
+		log.CompleteStep(copyStep)
 	}
-	log.CompleteStep(copyStep)
 
-	// Step 4: Run post-installation setup
+	// Step 4: Run post-installation setup (the docker step runs inside it)
 	postStep := log.StartStep("Running post-installation setup")
-	if err := runPostInstall(log, postStep, meta, projectDir); err != nil {
-		log.ErrorStep(postStep, err)
-		return fmt.Errorf("post-installation failed: %w", err)
+	if opts.NoInstall {
+		log.WarningStep(postStep, "Post-installation setup skipped (--no-install)")
+	} else if !opts.runs(StepPostInstall) && !opts.runs(StepDocker) {
+		log.WarningStep(postStep, "Post-installation setup skipped (step deselected)")
+	} else {
+		if err := runPostInstall(log, postStep, meta, projectDir, version, opts); err != nil {
+			log.ErrorStep(postStep, err)
+			return fmt.Errorf("post-installation failed: %w", err)
+		}
+		log.CompleteStep(postStep)
 	}
-	log.CompleteStep(postStep)
 
 	// Step 5: Register project and generate docker-compose
 	finalStep := log.StartStep("Registering project and generating docker-compose")
-	if err := finalizeProject(log, finalStep, meta, projectDir, projectName, version); err != nil {
+	if !opts.runs(StepRegister) {
+		log.WarningStep(finalStep, "Registration skipped (step deselected) - run 'atempo reconfigure' to generate docker-compose.yml")
+	} else if err := finalizeProject(log, finalStep, meta, projectDir, projectName, version, opts); err != nil {
 		log.WarningStep(finalStep, err.Error())
 	} else {
 		log.CompleteStep(finalStep)
@@ -126,16 +237,44 @@ func Run(framework string, version string, templatesFS, mcpServersFS embed.FS) e
 	return nil
 }
 
+// readTemplateMetadata reads the framework's template atempo.json (embedded first,
+// falling back to the templates directory next to the binary)
+func readTemplateMetadata(templatesFS embed.FS, framework string) ([]byte, error) {
+	embeddedPath := fmt.Sprintf("templates/frameworks/%s/atempo.json", framework)
+	if metaBytes, err := templatesFS.ReadFile(embeddedPath); err == nil {
+		return metaBytes, nil
+	}
+
+	filesystemPath, err := getFilesystemTemplatePath(framework, "atempo.json")
+	if err != nil {
+		return nil, fmt.Errorf("could not locate atempo.json for %s: %w", framework, err)
+	}
+	metaBytes, err := os.ReadFile(filesystemPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read atempo.json for %s: %w", framework, err)
+	}
+	return metaBytes, nil
+}
+
+// LoadMetadata loads the framework's template metadata
+func LoadMetadata(templatesFS embed.FS, framework string) (Metadata, error) {
+	var meta Metadata
+	metaBytes, err := readTemplateMetadata(templatesFS, strings.ToLower(framework))
+	if err != nil {
+		return meta, err
+	}
+	if err := json.Unmarshal(metaBytes, &meta); err != nil {
+		return meta, fmt.Errorf("invalid atempo.json for %s: %w", framework, err)
+	}
+	return meta, nil
+}
+
 // runInstaller executes the framework installation command
-func runInstaller(log *logger.Logger, step *logger.Step, meta Metadata, projectDir, projectName, version string) error {
+func runInstaller(log *logger.Logger, step *logger.Step, meta Metadata, projectDir, projectName, version string, vars map[string]string) error {
 	// Perform template variable substitution in the command
 	command := make([]string, len(meta.Installer.Command))
 	for i, part := range meta.Installer.Command {
-		part = strings.ReplaceAll(part, "{{name}}", "src")
-		part = strings.ReplaceAll(part, "{{cwd}}", projectDir)
-		part = strings.ReplaceAll(part, "{{project}}", projectName)
-		part = strings.ReplaceAll(part, "{{version}}", version)
-		command[i] = part
+		command[i] = processTemplateContent(part, projectName, projectDir, version, vars)
 	}
 
 	// Add version-specific logic for different frameworks
@@ -156,7 +295,8 @@ func runInstaller(log *logger.Logger, step *logger.Step, meta Metadata, projectD
 	return log.RunCommand(step, cmd)
 }
 
-// validateVersion checks if the requested version is compatible with the template
+// validateVersion checks the requested version against the template's min-version
+// and max-version
 func validateVersion(requestedVersion string, meta Metadata) error {
 	if requestedVersion == "" {
 		return fmt.Errorf("version cannot be empty")
@@ -170,47 +310,75 @@ func validateVersion(requestedVersion string, meta Metadata) error {
 		}
 	}
 
-	// Framework-specific version validation
-	switch meta.Framework {
-	case "laravel":
-		return validateLaravelVersion(requestedVersion)
-	case "django":
-		return validateDjangoVersion(requestedVersion)
+	// Check against the highest supported major version
+	if meta.MaxVersion != "" {
+		maxMajor := majorVersion(meta.MaxVersion)
+		if majorVersion(requestedVersion) > maxMajor {
+			return fmt.Errorf("version %s of %s is not yet supported (maximum: %d.x)",
+				requestedVersion, meta.Framework, maxMajor)
+		}
 	}
 
 	return nil
 }
 
-// validateLaravelVersion checks Laravel-specific version constraints
-func validateLaravelVersion(version string) error {
-	// Laravel version constraints
-	majorVersion := utils.ParseVersionPart(strings.Split(version, ".")[0])
-
-	if majorVersion < 8 {
-		return fmt.Errorf("Laravel version %s is too old (minimum supported: 8.0)", version)
+// ValidateFrameworkVersion checks a version against the framework template's bounds
+func ValidateFrameworkVersion(templatesFS embed.FS, framework, version string) error {
+	meta, err := LoadMetadata(templatesFS, framework)
+	if err != nil {
+		return err
 	}
+	return validateVersion(version, meta)
+}
 
-	if majorVersion > 12 {
-		return fmt.Errorf("Laravel version %s is not yet supported (maximum: 12.x)", version)
-	}
+// DefaultVersionKey is the setting that picks the version 'atempo create <framework>'
+// uses when none is given, e.g. "defaults.laravel.version"
+func DefaultVersionKey(framework string) string {
+	return "defaults." + framework + ".version"
+}
 
-	return nil
+// DefaultVersion returns the version to scaffold when none is requested: the
+// configured default, else the highest supported major version
+func DefaultVersion(templatesFS embed.FS, framework string) (string, error) {
+	if version := config.Get(DefaultVersionKey(framework)); version != "" {
+		return version, nil
+	}
+	meta, err := LoadMetadata(templatesFS, framework)
+	if err != nil {
+		return "", err
+	}
+	if meta.MaxVersion == "" {
+		return "", fmt.Errorf("no default version for %s: pass <framework>:<version> or set %s",
+			framework, DefaultVersionKey(framework))
+	}
+	return strconv.Itoa(majorVersion(meta.MaxVersion)), nil
 }
 
-// validateDjangoVersion checks Django-specific version constraints
-func validateDjangoVersion(version string) error {
-	// Django version constraints
-	majorVersion := utils.ParseVersionPart(strings.Split(version, ".")[0])
+// MajorBounds is the range of major versions Atempo can scaffold for a framework
+type MajorBounds struct {
+	Min int
+	Max int
+}
 
-	if majorVersion < 4 {
-		return fmt.Errorf("Django version %s is too old (minimum supported: 4.0)", version)
+// SupportedMajorVersions returns the supported major version range from the
+// framework template's min-version and max-version. It reports false when the
+// template can't be loaded or sets no max-version.
+func SupportedMajorVersions(templatesFS embed.FS, framework string) (MajorBounds, bool) {
+	meta, err := LoadMetadata(templatesFS, framework)
+	if err != nil || meta.MaxVersion == "" {
+		return MajorBounds{}, false
 	}
 
-	if majorVersion > 6 {
-		return fmt.Errorf("Django version %s is not yet supported (maximum: 6.x)", version)
+	bounds := MajorBounds{Max: majorVersion(meta.MaxVersion)}
+	if meta.MinVersion != "" {
+		bounds.Min = majorVersion(meta.MinVersion)
 	}
+	return bounds, true
+}
 
-	return nil
+// majorVersion returns the major part of a version such as "11", "11.2" or "12.x"
+func majorVersion(version string) int {
+	return utils.ParseVersionPart(strings.Split(version, ".")[0])
 }
 
 // applyVersionSpecificOptions modifies the installation command based on framework and version
@@ -249,20 +417,10 @@ func applyDjangoVersionOptions(command []string, version string) []string {
 }
 
 // copyTemplateFiles copies AI context, Docker setup, and other template files (embedded or filesystem)
-func copyTemplateFiles(log *logger.Logger, step *logger.Step, projectDir, projectName, framework, version string, templatesFS, mcpServersFS embed.FS) error {
+func copyTemplateFiles(log *logger.Logger, step *logger.Step, projectDir, projectName, framework, version string, vars map[string]string, templatesFS, mcpServersFS embed.FS) error {
 	// Copy AI context directory
-	aiDstPath := filepath.Join(projectDir, "ai")
-
-	// Try embedded first, fallback to filesystem
-	embeddedAiPath := fmt.Sprintf("templates/frameworks/%s/ai", framework)
-	if err := copyEmbeddedDirWithContext(templatesFS, embeddedAiPath, aiDstPath, projectName, projectDir, version); err != nil {
-		// Fallback to filesystem
-		aiSrcPath, pathErr := getFilesystemTemplateDir(framework, "ai")
-		if pathErr == nil {
-			if err := copyFilesystemDirWithContext(aiSrcPath, aiDstPath, projectName, projectDir, version); err != nil {
-				return fmt.Errorf("failed to copy AI context: %w", err)
-			}
-		}
+	if _, err := copyAIContext(projectDir, projectName, framework, version, vars, templatesFS); err != nil {
+		return err
 	}
 
 	// Copy MCP server for the framework
@@ -276,11 +434,11 @@ func copyTemplateFiles(log *logger.Logger, step *logger.Step, projectDir, projec
 
 	// Try embedded first, fallback to filesystem
 	embeddedInfraPath := fmt.Sprintf("templates/frameworks/%s/infra", framework)
-	if err := copyEmbeddedDirWithContext(templatesFS, embeddedInfraPath, infraDstPath, projectName, projectDir, version); err != nil {
+	if err := copyEmbeddedDirWithContext(templatesFS, embeddedInfraPath, infraDstPath, projectName, projectDir, version, vars); err != nil {
 		// Fallback to filesystem
 		infraSrcPath, pathErr := getFilesystemTemplateDir(framework, "infra")
 		if pathErr == nil {
-			if err := copyFilesystemDirWithContext(infraSrcPath, infraDstPath, projectName, projectDir, version); err != nil {
+			if err := copyFilesystemDirWithContext(infraSrcPath, infraDstPath, projectName, projectDir, version, vars); err != nil {
 				return fmt.Errorf("failed to copy infrastructure: %w", err)
 			}
 		}
@@ -291,11 +449,11 @@ func copyTemplateFiles(log *logger.Logger, step *logger.Step, projectDir, projec
 
 	// Try embedded first, fallback to filesystem
 	embeddedReadmePath := fmt.Sprintf("templates/frameworks/%s/README.md", framework)
-	if err := copyEmbeddedFileWithContext(templatesFS, embeddedReadmePath, readmeDstPath, projectName, projectDir, version); err != nil {
+	if err := copyEmbeddedFileWithContext(templatesFS, embeddedReadmePath, readmeDstPath, projectName, projectDir, version, vars); err != nil {
 		// Fallback to filesystem
 		readmeSrcPath, pathErr := getFilesystemTemplatePath(framework, "README.md")
 		if pathErr == nil {
-			if err := copyFilesystemFileWithContext(readmeSrcPath, readmeDstPath, projectName, projectDir, version); err != nil {
+			if err := copyFilesystemFileWithContext(readmeSrcPath, readmeDstPath, projectName, projectDir, version, vars); err != nil {
 				return fmt.Errorf("failed to copy README: %w", err)
 			}
 		}
@@ -304,9 +462,84 @@ func copyTemplateFiles(log *logger.Logger, step *logger.Step, projectDir, projec
 	return nil
 }
 
+// copyAIContext copies the framework's ai/ templates into the project with template
+// processing. It reports whether a template source was found.
+func copyAIContext(projectDir, projectName, framework, version string, vars map[string]string, templatesFS embed.FS) (bool, error) {
+	aiDstPath := filepath.Join(projectDir, mcp.AIContextDir)
+
+	// Try embedded first, fallback to filesystem
+	embeddedAiPath := fmt.Sprintf("templates/frameworks/%s/%s", framework, mcp.AIContextDir)
+	if err := copyEmbeddedDirWithContext(templatesFS, embeddedAiPath, aiDstPath, projectName, projectDir, version, vars); err != nil {
+		// Fallback to filesystem
+		aiSrcPath, pathErr := getFilesystemTemplateDir(framework, mcp.AIContextDir)
+		if pathErr != nil {
+			return false, nil
+		}
+		if err := copyFilesystemDirWithContext(aiSrcPath, aiDstPath, projectName, projectDir, version, vars); err != nil {
+			return false, fmt.Errorf("failed to copy AI context: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+// aiDirConflicts reports directories the framework installer created that collide
+// with the AI context directory: an ai/ that the templates would be merged into, or
+// a .ai/ that tools could mistake for it. existing lists what predates the run.
+func aiDirConflicts(projectDir string, existing map[string]bool) []string {
+	var conflicts []string
+	if !existing[mcp.AIContextDir] && utils.FileExists(filepath.Join(projectDir, mcp.AIContextDir)) {
+		conflicts = append(conflicts, fmt.Sprintf("The installer created %s/; Atempo's AI context templates will be merged into it", mcp.AIContextDir))
+	}
+	for _, dir := range []string{".ai", filepath.Join("src", ".ai")} {
+		if utils.FileExists(filepath.Join(projectDir, dir)) {
+			conflicts = append(conflicts, fmt.Sprintf("Found %s/ from the framework; Atempo's AI context is written to %s/, not %s/", dir, mcp.AIContextDir, dir))
+		}
+	}
+	return conflicts
+}
+
+// RefreshAIContext re-copies the framework's ai/ templates into an existing project
+// using the current project context. Compose files and the MCP server are left untouched.
+// It returns the framework whose templates were applied.
+func RefreshAIContext(projectDir string, templatesFS embed.FS) (string, error) {
+	config, err := compose.LoadAtempoConfig(projectDir)
+	if err != nil {
+		return "", err
+	}
+	if config.Framework == "" {
+		return "", fmt.Errorf("atempo.json in %s does not specify a framework", projectDir)
+	}
+
+	// Prefer the registered name and version so context matches 'atempo create'
+	projectName := filepath.Base(projectDir)
+	version := config.Version
+	if reg, err := registry.LoadRegistry(); err == nil {
+		for _, project := range reg.ListProjects() {
+			if project.Path == projectDir {
+				projectName = project.Name
+				if project.Version != "" {
+					version = project.Version
+				}
+				break
+			}
+		}
+	}
+
+	found, err := copyAIContext(projectDir, projectName, config.Framework, version, nil, templatesFS)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", fmt.Errorf("no AI context templates found for framework '%s'", config.Framework)
+	}
+
+	return config.Framework, nil
+}
+
 // copyMCPServer discovers and installs the best available MCP server for the framework
 func copyMCPServer(log *logger.Logger, step *logger.Step, framework, projectDir string, mcpServersFS embed.FS) error {
-	mcpDstPath := filepath.Join(projectDir, "ai", "mcp-server")
+	mcpDstPath := mcp.ServerDir(projectDir)
 
 	// Discover available MCP servers
 	discovery, err := mcp.DiscoverMCPServers(framework)
@@ -362,7 +595,7 @@ func copyMCPServer(log *logger.Logger, step *logger.Step, framework, projectDir
 }
 
 // finalizeProject registers the project and generates docker-compose.yml
-func finalizeProject(log *logger.Logger, step *logger.Step, meta Metadata, projectDir, projectName, version string) error {
+func finalizeProject(log *logger.Logger, step *logger.Step, meta Metadata, projectDir, projectName, version string, opts Options) error {
 	// Resolve project name from template
 	resolvedName := meta.Name
 	if resolvedName == "" || strings.Contains(resolvedName, "{{") {
@@ -375,20 +608,30 @@ func finalizeProject(log *logger.Logger, step *logger.Step, meta Metadata, proje
 	// Use the basename of the project directory as the registry name
 	// This ensures projects work with simple names even if created with paths
 	registryName := filepath.Base(projectDir)
-
-	// Register project in registry
-	reg, err := registry.LoadRegistry()
-	if err != nil {
-		return fmt.Errorf("failed to load registry: %w", err)
+	if opts.Name != "" {
+		registryName = opts.Name
 	}
 
-	if err := reg.AddProject(registryName, projectDir, meta.Framework, version); err != nil {
-		return fmt.Errorf("failed to register project: %w", err)
+	// Register project in registry, unless it is a throwaway (--no-register)
+	if !opts.NoRegister {
+		reg, err := registry.LoadRegistry()
+		if err != nil {
+			return fmt.Errorf("failed to load registry: %w", err)
+		}
+
+		if err := reg.AddProject(registryName, projectDir, meta.Framework, version); err != nil {
+			return fmt.Errorf("failed to register project: %w", err)
+		}
 	}
 
 	// Generate docker-compose.yml from atempo.json if it has services defined
 	atempoJsonPath := filepath.Join(projectDir, "atempo.json")
 	if utils.FileExists(atempoJsonPath) {
+		if opts.Name != "" {
+			if err := compose.SetProjectName(projectDir, opts.Name); err != nil {
+				return fmt.Errorf("failed to set project name in atempo.json: %w", err)
+			}
+		}
 		if err := compose.GenerateDockerCompose(projectDir); err != nil {
 			return fmt.Errorf("failed to generate docker-compose.yml: %w", err)
 		}
@@ -397,23 +640,79 @@ func finalizeProject(log *logger.Logger, step *logger.Step, meta Metadata, proje
 	return nil
 }
 
-// runPostInstall handles framework-specific setup after installation
-func runPostInstall(log *logger.Logger, step *logger.Step, meta Metadata, projectDir string) error {
-	// Set up Laravel environment file
-	if meta.Framework == "laravel" {
-		return setupLaravel(log, step, projectDir)
+// runPostInstall prepares framework files, then starts services and runs the setup
+// commands (post_install hooks, or the framework defaults) unless the docker step is skipped
+func runPostInstall(log *logger.Logger, step *logger.Step, meta Metadata, projectDir, version string, opts Options) error {
+	// Hooks declared in atempo.json replace the framework's default setup commands
+	var hooks []compose.PostInstallHook
+	if config, err := compose.LoadAtempoConfig(projectDir); err == nil {
+		hooks = config.PostInstallHooks()
 	}
 
-	// Set up Django environment
-	if meta.Framework == "django" {
-		return setupDjango(log, step, projectDir)
+	if opts.Seed {
+		if len(hooks) > 0 {
+			log.WarningStep(step, "--seed is ignored because atempo.json declares post_install hooks - add a seed hook instead")
+		} else if meta.Framework != "laravel" {
+			log.WarningStep(step, fmt.Sprintf("--seed is not supported for %s during create - load data with 'atempo seed' once fixtures exist", meta.Framework))
+		}
 	}
 
-	return nil
+	if opts.runs(StepPostInstall) {
+		switch meta.Framework {
+		case "laravel":
+			if err := setupLaravel(projectDir); err != nil {
+				return err
+			}
+		case "django":
+			if err := setupDjango(projectDir, version); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(hooks) == 0 && meta.Framework != "laravel" && meta.Framework != "django" {
+		return nil
+	}
+
+	if !opts.runs(StepDocker) {
+		log.WarningStep(step, "Docker startup skipped (step deselected) - run 'atempo docker up' and the setup commands manually")
+		return nil
+	}
+
+	// Check if Docker is available and start services
+	if err := startDockerServices(log, step, projectDir); err != nil {
+		log.WarningStep(step, "Docker not available or failed to start services - run 'docker-compose up -d' manually")
+		return nil // Don't fail the entire setup if Docker isn't available
+	}
+
+	switch {
+	case len(hooks) > 0:
+		runPostInstallHooks(log, step, projectDir, hooks)
+		return nil
+	case meta.Framework == "laravel":
+		return runLaravelSetup(log, step, projectDir, opts.Seed)
+	default:
+		return runDjangoSetup(log, step, projectDir)
+	}
+}
+
+// runPostInstallHooks executes atempo.json post_install hooks in order via compose exec
+func runPostInstallHooks(log *logger.Logger, step *logger.Step, projectDir string, hooks []compose.PostInstallHook) {
+	for i, hook := range hooks {
+		args, err := hook.Args()
+		if err != nil {
+			log.WarningStep(step, fmt.Sprintf("Skipping post_install hook %d: %v", i+1, err))
+			continue
+		}
+
+		command := utils.ComposeArgs("exec", "-T", hook.Container)
+		command = append(command, args...)
+		runSetupCommands(log, step, projectDir, hook.Container, [][]string{command})
+	}
 }
 
-// setupLaravel performs Laravel-specific post-installation setup
-func setupLaravel(log *logger.Logger, step *logger.Step, projectDir string) error {
+// setupLaravel prepares the Laravel .env file for the Docker services
+func setupLaravel(projectDir string) error {
 	srcDir := filepath.Join(projectDir, "src")
 
 	// Copy .env.example to .env
@@ -427,22 +726,15 @@ func setupLaravel(log *logger.Logger, step *logger.Step, projectDir string) erro
 	}
 
 	// Update .env with Docker database configuration
-	if err := updateLaravelEnv(envFile); err != nil {
+	if err := updateLaravelEnv(envFile, projectDir); err != nil {
 		return fmt.Errorf("failed to update .env: %w", err)
 	}
 
-	// Check if Docker is available and start services
-	if err := startDockerServices(log, step, projectDir); err != nil {
-		log.WarningStep(step, "Docker not available or failed to start services - run 'docker-compose up -d' manually")
-		return nil // Don't fail the entire setup if Docker isn't available
-	}
-
-	// Run Laravel setup commands
-	return runLaravelSetup(log, step, projectDir)
+	return nil
 }
 
 // updateLaravelEnv updates the .env file with Docker-specific configuration
-func updateLaravelEnv(envFile string) error {
+func updateLaravelEnv(envFile, projectDir string) error {
 	// Read current .env content
 	content, err := os.ReadFile(envFile)
 	if err != nil {
@@ -451,11 +743,10 @@ func updateLaravelEnv(envFile string) error {
 
 	envContent := string(content)
 
-	// Update database configuration for Docker
-	envContent = strings.ReplaceAll(envContent, "DB_HOST=127.0.0.1", "DB_HOST=mysql")
-	envContent = strings.ReplaceAll(envContent, "DB_DATABASE=laravel", "DB_DATABASE=laravel")
-	envContent = strings.ReplaceAll(envContent, "DB_USERNAME=root", "DB_USERNAME=laravel")
-	envContent = strings.ReplaceAll(envContent, "DB_PASSWORD=", "DB_PASSWORD=laravel")
+	// Point Laravel at the database service with the credentials it actually uses
+	for _, setting := range laravelDatabaseSettings(projectDir) {
+		envContent = setEnvValue(envContent, setting[0], setting[1])
+	}
 
 	// Add Redis configuration
 	if !strings.Contains(envContent, "REDIS_HOST=") {
@@ -465,37 +756,107 @@ func updateLaravelEnv(envFile string) error {
 	return os.WriteFile(envFile, []byte(envContent), 0644)
 }
 
+// laravelDatabaseSettings derives the DB_* .env values from the MySQL/MariaDB service
+// in atempo.json, falling back to the template defaults when none is declared
+func laravelDatabaseSettings(projectDir string) [][2]string {
+	host, database, username, password := "mysql", "laravel", "laravel", "laravel"
+
+	if config, err := compose.LoadAtempoConfig(projectDir); err == nil {
+		if name, service, ok := findDatabaseService(config.Services); ok {
+			env := service.Environment
+			host = name
+			database = firstEnv(env, database, "MYSQL_DATABASE", "MARIADB_DATABASE")
+			username = firstEnv(env, "root", "MYSQL_USER", "MARIADB_USER")
+			if username == "root" {
+				password = firstEnv(env, "", "MYSQL_ROOT_PASSWORD", "MARIADB_ROOT_PASSWORD")
+			} else {
+				password = firstEnv(env, "", "MYSQL_PASSWORD", "MARIADB_PASSWORD")
+			}
+		}
+	}
+
+	return [][2]string{
+		{"DB_CONNECTION", "mysql"},
+		{"DB_HOST", host},
+		{"DB_PORT", "3306"},
+		{"DB_DATABASE", database},
+		{"DB_USERNAME", username},
+		{"DB_PASSWORD", password},
+	}
+}
+
+// findDatabaseService returns the "mysql" service, or else the first service whose
+// image is MySQL or MariaDB
+func findDatabaseService(services map[string]compose.Service) (string, compose.Service, bool) {
+	if service, ok := services["mysql"]; ok {
+		return "mysql", service, true
+	}
+
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		image := services[name].Image
+		if strings.HasPrefix(image, "mysql") || strings.HasPrefix(image, "mariadb") {
+			return name, services[name], true
+		}
+	}
+	return "", compose.Service{}, false
+}
+
+// firstEnv returns the first non-empty value among keys, or fallback
+func firstEnv(env map[string]string, fallback string, keys ...string) string {
+	for _, key := range keys {
+		if value := env[key]; value != "" {
+			return value
+		}
+	}
+	return fallback
+}
+
+// setEnvValue sets KEY=value in .env content, replacing an existing (possibly
+// commented-out) entry or appending one
+func setEnvValue(content, key, value string) string {
+	pattern := regexp.MustCompile(`(?m)^#?\s*` + regexp.QuoteMeta(key) + `=.*$`)
+	if loc := pattern.FindStringIndex(content); loc != nil {
+		return content[:loc[0]] + key + "=" + value + content[loc[1]:]
+	}
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	return content + key + "=" + value + "\n"
+}
+
 // startDockerServices attempts to start Docker services
 func startDockerServices(log *logger.Logger, step *logger.Step, projectDir string) error {
-	cmd := exec.Command("docker-compose", "up", "-d")
+	cmd := utils.ComposeCommand("up", "-d")
 	cmd.Dir = projectDir
 
 	return log.RunCommand(step, cmd)
 }
 
 // runLaravelSetup runs essential Laravel setup commands in Docker
-func runLaravelSetup(log *logger.Logger, step *logger.Step, projectDir string) error {
-	commands := [][]string{
-		{"docker-compose", "exec", "-T", "app", "composer", "install"},
-		{"docker-compose", "exec", "-T", "app", "php", "artisan", "key:generate"},
-		{"docker-compose", "exec", "-T", "app", "php", "artisan", "migrate", "--force"},
+func runLaravelSetup(log *logger.Logger, step *logger.Step, projectDir string, seed bool) error {
+	migrate := utils.ComposeArgs("exec", "-T", "app", "php", "artisan", "migrate", "--force")
+	if seed {
+		migrate = append(migrate, "--seed")
 	}
 
-	for _, command := range commands {
-		cmd := exec.Command(command[0], command[1:]...)
-		cmd.Dir = projectDir
-
-		if err := log.RunCommand(step, cmd); err != nil {
-			log.WarningStep(step, fmt.Sprintf("Command failed: %s - you may need to run this manually", strings.Join(command, " ")))
-			continue // Continue with other commands
-		}
+	commands := [][]string{
+		utils.ComposeArgs("exec", "-T", "app", "composer", "install"),
+		utils.ComposeArgs("exec", "-T", "app", "php", "artisan", "key:generate"),
+		migrate,
 	}
 
+	runSetupCommands(log, step, projectDir, "app", commands)
 	return nil
 }
 
-// setupDjango performs Django-specific post-installation setup
-func setupDjango(log *logger.Logger, step *logger.Step, projectDir string) error {
+// setupDjango prepares the Django requirements.txt for the Docker services
+func setupDjango(projectDir, version string) error {
 	srcDir := filepath.Join(projectDir, "src")
 
 	// Copy and update requirements.txt from Docker template
@@ -503,32 +864,23 @@ func setupDjango(log *logger.Logger, step *logger.Step, projectDir string) error
 	requirementsDst := filepath.Join(srcDir, "requirements.txt")
 
 	if utils.FileExists(requirementsSrc) {
-		if err := copyAndUpdateRequirements(requirementsSrc, requirementsDst, projectDir); err != nil {
+		if err := copyAndUpdateRequirements(requirementsSrc, requirementsDst, version); err != nil {
 			return fmt.Errorf("failed to copy requirements.txt: %w", err)
 		}
 	}
 
-	// Check if Docker is available and start services
-	if err := startDockerServices(log, step, projectDir); err != nil {
-		log.WarningStep(step, "Docker not available or failed to start services - run 'docker-compose up -d' manually")
-		return nil // Don't fail the entire setup if Docker isn't available
-	}
-
-	// Run Django setup commands
-	return runDjangoSetup(log, step, projectDir)
+	return nil
 }
 
-// copyAndUpdateRequirements copies requirements.txt and updates Django version
-func copyAndUpdateRequirements(src, dst, projectDir string) error {
+// copyAndUpdateRequirements copies requirements.txt and pins Django to the
+// requested major version
+func copyAndUpdateRequirements(src, dst, version string) error {
 	// Read the template requirements.txt
 	content, err := os.ReadFile(src)
 	if err != nil {
 		return fmt.Errorf("failed to read requirements template: %w", err)
 	}
 
-	// Get the requested Django version from the project name or context
-	version := extractVersionFromProject(projectDir)
-
 	if version != "" {
 		// Update Django version in requirements
 		reqContent := string(content)
@@ -547,50 +899,63 @@ func copyAndUpdateRequirements(src, dst, projectDir string) error {
 	return os.WriteFile(dst, content, 0644)
 }
 
-// extractVersionFromProject attempts to extract the Django version from project context
-func extractVersionFromProject(projectDir string) string {
-	// For now, return default version
-	// TODO: Pass version parameter through the entire call stack
-	return "5"
-}
-
 // runDjangoSetup runs essential Django setup commands in Docker
 func runDjangoSetup(log *logger.Logger, step *logger.Step, projectDir string) error {
 	commands := [][]string{
-		{"docker-compose", "exec", "-T", "web", "pip", "install", "-r", "requirements.txt"},
-		{"docker-compose", "exec", "-T", "web", "python", "manage.py", "migrate"},
-		{"docker-compose", "exec", "-T", "web", "python", "manage.py", "collectstatic", "--noinput"},
+		utils.ComposeArgs("exec", "-T", "web", "pip", "install", "-r", "requirements.txt"),
+		utils.ComposeArgs("exec", "-T", "web", "python", "manage.py", "migrate"),
+		utils.ComposeArgs("exec", "-T", "web", "python", "manage.py", "collectstatic", "--noinput"),
 	}
 
+	runSetupCommands(log, step, projectDir, "web", commands)
+	return nil
+}
+
+// runSetupCommands runs post-install commands against a service, continuing past
+// failures. After a failure the service's recent container logs are appended to the
+// setup log so 'atempo logs' shows why (e.g. the database refused connections).
+func runSetupCommands(log *logger.Logger, step *logger.Step, projectDir, service string, commands [][]string) {
+	logsCaptured := false
+
 	for _, command := range commands {
 		cmd := exec.Command(command[0], command[1:]...)
 		cmd.Dir = projectDir
 
 		if err := log.RunCommand(step, cmd); err != nil {
 			log.WarningStep(step, fmt.Sprintf("Command failed: %s - you may need to run this manually", strings.Join(command, " ")))
+
+			// Later failures usually share the cause, so capture the logs once
+			if !logsCaptured {
+				logsCmd := utils.ComposeCommand("logs", "--no-color", "--tail", "50", service)
+				logsCmd.Dir = projectDir
+				log.AttachOutput(fmt.Sprintf("CONTAINER LOGS %s", service), logsCmd)
+				logsCaptured = true
+			}
 			continue // Continue with other commands
 		}
 	}
-
-	return nil
 }
 
-// processTemplateContent processes template variables in content
-func processTemplateContent(content string, projectName, projectDir, version string) string {
+// processTemplateContent processes template variables in content, followed by any
+// custom variables set with --set
+func processTemplateContent(content string, projectName, projectDir, version string, vars map[string]string) string {
 	content = strings.ReplaceAll(content, "{{project}}", projectName)
 	content = strings.ReplaceAll(content, "{{name}}", "src")
 	content = strings.ReplaceAll(content, "{{cwd}}", projectDir)
 	content = strings.ReplaceAll(content, "{{version}}", version)
+	for key, value := range vars {
+		content = strings.ReplaceAll(content, "{{"+key+"}}", value)
+	}
 	return content
 }
 
 // copyEmbeddedFile copies a single file from embedded filesystem to local filesystem with template processing
 func copyEmbeddedFile(fsys embed.FS, srcPath, dstPath string) error {
-	return copyEmbeddedFileWithContext(fsys, srcPath, dstPath, "", "", "")
+	return copyEmbeddedFileWithContext(fsys, srcPath, dstPath, "", "", "", nil)
 }
 
 // copyEmbeddedFileWithContext copies a file with template variable processing
-func copyEmbeddedFileWithContext(fsys embed.FS, srcPath, dstPath, projectName, projectDir, version string) error {
+func copyEmbeddedFileWithContext(fsys embed.FS, srcPath, dstPath, projectName, projectDir, version string, vars map[string]string) error {
 	// Read file from embedded filesystem
 	data, err := fsys.ReadFile(srcPath)
 	if err != nil {
@@ -606,7 +971,7 @@ func copyEmbeddedFileWithContext(fsys embed.FS, srcPath, dstPath, projectName, p
 	var processedData []byte
 	if projectName != "" {
 		content := string(data)
-		processedContent := processTemplateContent(content, projectName, projectDir, version)
+		processedContent := processTemplateContent(content, projectName, projectDir, version, vars)
 		processedData = []byte(processedContent)
 	} else {
 		processedData = data
@@ -622,11 +987,11 @@ func copyEmbeddedFileWithContext(fsys embed.FS, srcPath, dstPath, projectName, p
 
 // copyEmbeddedDir recursively copies a directory from embedded filesystem to local filesystem
 func copyEmbeddedDir(fsys embed.FS, srcPath, dstPath string) error {
-	return copyEmbeddedDirWithContext(fsys, srcPath, dstPath, "", "", "")
+	return copyEmbeddedDirWithContext(fsys, srcPath, dstPath, "", "", "", nil)
 }
 
 // copyEmbeddedDirWithContext recursively copies a directory with template variable processing
-func copyEmbeddedDirWithContext(fsys embed.FS, srcPath, dstPath, projectName, projectDir, version string) error {
+func copyEmbeddedDirWithContext(fsys embed.FS, srcPath, dstPath, projectName, projectDir, version string, vars map[string]string) error {
 	// Create destination directory
 	if err := os.MkdirAll(dstPath, 0755); err != nil {
 		return fmt.Errorf("failed to create destination directory: %w", err)
@@ -657,13 +1022,13 @@ func copyEmbeddedDirWithContext(fsys embed.FS, srcPath, dstPath, projectName, pr
 			return os.MkdirAll(destPath, 0755)
 		} else {
 			// Copy file with template processing
-			return copyEmbeddedFileWithContext(fsys, path, destPath, projectName, projectDir, version)
+			return copyEmbeddedFileWithContext(fsys, path, destPath, projectName, projectDir, version, vars)
 		}
 	})
 }
 
 // copyFilesystemDirWithContext copies a directory from filesystem with template processing
-func copyFilesystemDirWithContext(srcPath, dstPath, projectName, projectDir, version string) error {
+func copyFilesystemDirWithContext(srcPath, dstPath, projectName, projectDir, version string, vars map[string]string) error {
 	// Create destination directory
 	if err := os.MkdirAll(dstPath, 0755); err != nil {
 		return fmt.Errorf("failed to create destination directory: %w", err)
@@ -694,13 +1059,13 @@ func copyFilesystemDirWithContext(srcPath, dstPath, projectName, projectDir, ver
 			return os.MkdirAll(destPath, 0755)
 		} else {
 			// Copy file with template processing
-			return copyFilesystemFileWithContext(path, destPath, projectName, projectDir, version)
+			return copyFilesystemFileWithContext(path, destPath, projectName, projectDir, version, vars)
 		}
 	})
 }
 
 // copyFilesystemFileWithContext copies a file from filesystem with template processing
-func copyFilesystemFileWithContext(srcPath, dstPath, projectName, projectDir, version string) error {
+func copyFilesystemFileWithContext(srcPath, dstPath, projectName, projectDir, version string, vars map[string]string) error {
 	// Read file from filesystem
 	data, err := os.ReadFile(srcPath)
 	if err != nil {
@@ -716,7 +1081,7 @@ func copyFilesystemFileWithContext(srcPath, dstPath, projectName, projectDir, ve
 	var processedData []byte
 	if projectName != "" {
 		content := string(data)
-		processedContent := processTemplateContent(content, projectName, projectDir, version)
+		processedContent := processTemplateContent(content, projectName, projectDir, version, vars)
 		processedData = []byte(processedContent)
 	} else {
 		processedData = data
@@ -831,16 +1196,5 @@ func getFilesystemMCPPath(framework string) (string, error) {
 
 // checkDockerAvailability verifies that Docker is installed and running
 func checkDockerAvailability() error {
-	// First check if docker command is available
-	if _, err := exec.LookPath("docker"); err != nil {
-		return fmt.Errorf("docker command not found in PATH")
-	}
-	
-	// Check if Docker daemon is running by running a simple command
-	cmd := exec.Command("docker", "info")
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("docker daemon is not running")
-	}
-	
-	return nil
+	return docker.CheckDaemon()
 }
@@ -0,0 +1,77 @@
+package scaffold
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"atempo/internal/compose"
+)
+
+// frameworkOwnedKeys are atempo.json fields that always come from the framework
+// template, because they describe how the framework itself is installed
+var frameworkOwnedKeys = []string{"framework", "language", "installer", "working-dir", "min-version", "max-version"}
+
+// mergeStackTemplate overlays a user-provided atempo.json skeleton (services,
+// volumes, networks and other project settings) onto the framework template.
+// Installer metadata always comes from the framework template.
+func mergeStackTemplate(frameworkConfig []byte, templatePath string) ([]byte, error) {
+	templateData, err := os.ReadFile(templatePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stack template: %w", err)
+	}
+
+	base, err := compose.ParseJSONObject(frameworkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid framework atempo.json: %w", err)
+	}
+
+	stack, err := compose.ParseJSONObject(templateData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid stack template %s: %w", templatePath, err)
+	}
+
+	var baseFramework, stackFramework string
+	if raw, ok := base.Get("framework"); ok {
+		json.Unmarshal(raw, &baseFramework)
+	}
+	if raw, ok := stack.Get("framework"); ok {
+		json.Unmarshal(raw, &stackFramework)
+	}
+	if stackFramework != "" && stackFramework != baseFramework {
+		return nil, fmt.Errorf("stack template %s is for '%s', not '%s'", templatePath, stackFramework, baseFramework)
+	}
+
+	// Stack keys replace framework keys in place; new keys follow in the stack's order
+	for _, key := range stack.Keys() {
+		if isFrameworkOwnedKey(key) {
+			continue
+		}
+		value, _ := stack.Get(key)
+		if err := base.Set(key, value); err != nil {
+			return nil, fmt.Errorf("invalid stack template %s: %w", templatePath, err)
+		}
+	}
+
+	return compose.MarshalConfigJSON(base)
+}
+
+// isFrameworkOwnedKey reports whether an atempo.json key is controlled by the framework template
+func isFrameworkOwnedKey(key string) bool {
+	for _, owned := range frameworkOwnedKeys {
+		if key == owned {
+			return true
+		}
+	}
+	return false
+}
+
+// writeProjectConfig writes the resolved atempo.json into the project directory
+func writeProjectConfig(projectDir, projectName, version string, vars map[string]string, config []byte) error {
+	content := processTemplateContent(string(config), projectName, projectDir, version, vars)
+	if err := os.WriteFile(filepath.Join(projectDir, "atempo.json"), []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write atempo.json: %w", err)
+	}
+	return nil
+}
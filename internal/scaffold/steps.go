@@ -0,0 +1,68 @@
+package scaffold
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scaffold step labels accepted by Options.Only and Options.Skip
+const (
+	StepInstall     = "install"      // Framework installer (e.g. composer create-project)
+	StepTemplates   = "templates"    // Copy template files
+	StepPostInstall = "post-install" // Prepare .env/requirements before the first start
+	StepDocker      = "docker"       // Start services and run setup commands in containers
+	StepRegister    = "register"     // Register the project and generate docker-compose.yml
+)
+
+// Steps lists the selectable scaffold steps in execution order
+var Steps = []string{StepInstall, StepTemplates, StepPostInstall, StepDocker, StepRegister}
+
+// ParseSteps parses a comma-separated list of step labels
+func ParseSteps(value string) ([]string, error) {
+	var steps []string
+	for _, part := range strings.Split(value, ",") {
+		step := strings.TrimSpace(part)
+		if step == "" {
+			continue
+		}
+		if !isStep(step) {
+			return nil, fmt.Errorf("unknown scaffold step '%s' (valid: %s)", step, strings.Join(Steps, ", "))
+		}
+		steps = append(steps, step)
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("no scaffold steps given (valid: %s)", strings.Join(Steps, ", "))
+	}
+	return steps, nil
+}
+
+// isStep reports whether label names a selectable step
+func isStep(label string) bool {
+	for _, step := range Steps {
+		if step == label {
+			return true
+		}
+	}
+	return false
+}
+
+// runs reports whether the given step is selected by Only/Skip and NoInstall
+func (o Options) runs(step string) bool {
+	if o.NoInstall && (step == StepInstall || step == StepPostInstall || step == StepDocker) {
+		return false
+	}
+	if len(o.Only) > 0 {
+		return containsStep(o.Only, step)
+	}
+	return !containsStep(o.Skip, step)
+}
+
+// containsStep reports whether steps includes step
+func containsStep(steps []string, step string) bool {
+	for _, s := range steps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
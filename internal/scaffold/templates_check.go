@@ -0,0 +1,107 @@
+package scaffold
+
+import (
+	"embed"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// TemplateComponents are the parts of a framework template that scaffolding copies,
+// in the order they are checked. Only atempo.json is required.
+var TemplateComponents = []string{"atempo.json", "ai", "infra", "README.md"}
+
+// Template component sources, in the order scaffolding tries them
+const (
+	SourceEmbedded   = "embedded"
+	SourceFilesystem = "filesystem"
+)
+
+// TemplateComponent reports where one part of a framework template can be found
+type TemplateComponent struct {
+	Name           string `json:"name"`
+	Embedded       bool   `json:"embedded"`
+	FilesystemPath string `json:"filesystem_path,omitempty"`
+	Source         string `json:"source,omitempty"` // Source scaffolding resolves it from, "" when missing
+}
+
+// FrameworkTemplate is the resolution of every component of one framework's template
+type FrameworkTemplate struct {
+	Framework  string              `json:"framework"`
+	Components []TemplateComponent `json:"components"`
+}
+
+// InspectTemplates resolves each template component for every framework found in the
+// embedded templates or the filesystem fallback, the same way Run does: embedded
+// first, then templates/frameworks next to the binary, its parent or the working directory.
+func InspectTemplates(templatesFS embed.FS) []FrameworkTemplate {
+	var templates []FrameworkTemplate
+	for _, framework := range discoverFrameworks(templatesFS) {
+		template := FrameworkTemplate{Framework: framework}
+		for _, name := range TemplateComponents {
+			component := TemplateComponent{Name: name}
+			if _, err := fs.Stat(templatesFS, "templates/frameworks/"+framework+"/"+name); err == nil {
+				component.Embedded = true
+				component.Source = SourceEmbedded
+			}
+			if path, err := getFilesystemTemplatePath(framework, name); err == nil {
+				component.FilesystemPath = path
+				if component.Source == "" {
+					component.Source = SourceFilesystem
+				}
+			}
+			template.Components = append(template.Components, component)
+		}
+		templates = append(templates, template)
+	}
+	return templates
+}
+
+// discoverFrameworks lists the framework directories in the embedded templates and
+// in each filesystem fallback location, sorted and without duplicates
+func discoverFrameworks(templatesFS embed.FS) []string {
+	seen := make(map[string]bool)
+	if entries, err := fs.ReadDir(templatesFS, "templates/frameworks"); err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() {
+				seen[entry.Name()] = true
+			}
+		}
+	}
+
+	for _, dir := range filesystemFrameworkDirs() {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				seen[entry.Name()] = true
+			}
+		}
+	}
+
+	frameworks := make([]string, 0, len(seen))
+	for framework := range seen {
+		frameworks = append(frameworks, framework)
+	}
+	sort.Strings(frameworks)
+	return frameworks
+}
+
+// filesystemFrameworkDirs returns the templates/frameworks directories searched by
+// getFilesystemTemplatePath, in the same order
+func filesystemFrameworkDirs() []string {
+	var dirs []string
+	if executable, err := os.Executable(); err == nil {
+		execDir := filepath.Dir(executable)
+		dirs = append(dirs,
+			filepath.Join(execDir, "templates", "frameworks"),
+			filepath.Join(filepath.Dir(execDir), "templates", "frameworks"))
+	}
+	if cwd, err := os.Getwd(); err == nil {
+		dirs = append(dirs, filepath.Join(cwd, "templates", "frameworks"))
+	}
+	return dirs
+}
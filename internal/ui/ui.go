@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+)
+
+// Output settings shared by every command for a single CLI invocation
+var (
+	quiet bool
+)
+
+// SetQuiet enables or disables quiet mode. In quiet mode decorative output
+// (progress lines, banners, hints) and ANSI colors are suppressed so that only
+// essential results and errors are printed.
+func SetQuiet(enabled bool) {
+	quiet = enabled
+}
+
+// IsQuiet reports whether quiet mode is enabled
+func IsQuiet() bool {
+	return quiet
+}
+
+// ColorEnabled reports whether ANSI color codes should be emitted.
+// Colors are disabled in quiet mode, when NO_COLOR is set to a non-empty value
+// (https://no-color.org)
+// and when stdout is not a terminal (e.g. output piped or redirected to a file).
+func ColorEnabled() bool {
+	if quiet {
+		return false
+	}
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return IsTerminal(os.Stdout)
+}
+
+// IsTerminal reports whether the given file is attached to a terminal
+func IsTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Color returns the given ANSI escape code, or an empty string when colors are disabled
+func Color(code string) string {
+	if !ColorEnabled() {
+		return ""
+	}
+	return code
+}
+
+// Printf prints decorative output that is suppressed in quiet mode
+func Printf(format string, args ...interface{}) {
+	if quiet {
+		return
+	}
+	fmt.Printf(format, args...)
+}
+
+// Println prints a decorative line that is suppressed in quiet mode
+func Println(args ...interface{}) {
+	if quiet {
+		return
+	}
+	fmt.Println(args...)
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+// Compose binary detection cache
+var (
+	composeBinary []string
+	composeMutex  sync.Mutex
+)
+
+// ComposeBinary returns the command used to invoke Docker Compose: the standalone
+// "docker-compose" binary when installed, otherwise the "docker compose" plugin.
+// Detection runs once per process.
+func ComposeBinary() []string {
+	composeMutex.Lock()
+	defer composeMutex.Unlock()
+
+	if composeBinary != nil {
+		return composeBinary
+	}
+
+	composeBinary = []string{"docker-compose"}
+	if _, err := exec.LookPath("docker-compose"); err != nil && composePluginAvailable() {
+		composeBinary = []string{"docker", "compose"}
+	}
+
+	return composeBinary
+}
+
+// ComposeAvailable reports whether either form of Docker Compose can be run
+func ComposeAvailable() bool {
+	if _, err := exec.LookPath("docker-compose"); err == nil {
+		return true
+	}
+	return composePluginAvailable()
+}
+
+// ComposeArgs returns a full Docker Compose command line for the given arguments
+func ComposeArgs(args ...string) []string {
+	binary := ComposeBinary()
+	command := make([]string, 0, len(binary)+len(args))
+	command = append(command, binary...)
+	return append(command, args...)
+}
+
+// ComposeCommand builds an exec.Cmd running Docker Compose with the given arguments
+func ComposeCommand(args ...string) *exec.Cmd {
+	command := ComposeArgs(args...)
+	return exec.Command(command[0], command[1:]...)
+}
+
+// composePluginAvailable checks whether 'docker compose' (Compose v2 plugin) works
+func composePluginAvailable() bool {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return exec.CommandContext(ctx, "docker", "compose", "version").Run() == nil
+}
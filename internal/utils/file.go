@@ -147,4 +147,66 @@ func ParseVersionPart(part string) int {
 	}
 	
 	return result
-}
\ No newline at end of file
+}
+
+// FormatBytes formats a byte count for display (e.g. "512 KB", "1.5 GB")
+func FormatBytes(bytes uint64) string {
+	const gb = 1 << 30
+	const mb = 1 << 20
+	const kb = 1 << 10
+	switch {
+	case bytes >= gb:
+		return fmt.Sprintf("%.1f GB", float64(bytes)/gb)
+	case bytes >= mb:
+		return fmt.Sprintf("%d MB", bytes/mb)
+	case bytes >= kb:
+		return fmt.Sprintf("%d KB", bytes/kb)
+	default:
+		return fmt.Sprintf("%d B", bytes)
+	}
+}
+
+// maxProjectRootDepth bounds how many parent directories FindProjectRoot searches
+const maxProjectRootDepth = 5
+
+// FindProjectRoot walks up from startDir (like git does for .git) to the nearest
+// directory containing atempo.json, falling back to the nearest docker-compose.yml
+// (an app in src/ may ship its own compose file). It reports false when neither is
+// found within maxProjectRootDepth parents.
+func FindProjectRoot(startDir string) (string, bool) {
+	dir, err := filepath.Abs(startDir)
+	if err != nil {
+		return "", false
+	}
+
+	composeRoot := ""
+	for depth := 0; depth <= maxProjectRootDepth; depth++ {
+		if FileExists(filepath.Join(dir, "atempo.json")) {
+			return dir, true
+		}
+		if composeRoot == "" && FileExists(filepath.Join(dir, "docker-compose.yml")) {
+			composeRoot = dir
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break // Reached the filesystem root
+		}
+		dir = parent
+	}
+
+	return composeRoot, composeRoot != ""
+}
+
+// CurrentProjectDir returns the project root containing the working directory,
+// or the working directory itself when it is not inside a project
+func CurrentProjectDir() (string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	if root, ok := FindProjectRoot(cwd); ok {
+		return root, nil
+	}
+	return cwd, nil
+}
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// mkProject creates dir (and its parents) under root, optionally with the named files
+func mkProject(t *testing.T, root, dir string, files ...string) string {
+	t.Helper()
+	path := filepath.Join(root, dir)
+	if err := os.MkdirAll(path, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(path, file), []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+func TestFindProjectRoot(t *testing.T) {
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	project := mkProject(t, root, "shop", "atempo.json", "docker-compose.yml")
+	mkProject(t, root, "shop/src", "docker-compose.yml")
+	nested := mkProject(t, root, "shop/src/app/Http/Controllers")
+	composeOnly := mkProject(t, root, "legacy", "docker-compose.yml")
+	composeNested := mkProject(t, root, "legacy/app")
+	tooDeep := mkProject(t, root, "shop/src/a/b/c/d/e")
+	outside := mkProject(t, root, "scratch/notes")
+
+	tests := []struct {
+		name     string
+		startDir string
+		want     string
+		wantOK   bool
+	}{
+		{name: "project root", startDir: project, want: project, wantOK: true},
+		{name: "nested directory prefers atempo.json over src/docker-compose.yml", startDir: nested, want: project, wantOK: true},
+		{name: "docker-compose.yml fallback", startDir: composeNested, want: composeOnly, wantOK: true},
+		{name: "beyond the depth limit", startDir: tooDeep, want: filepath.Join(project, "src"), wantOK: true},
+		{name: "no project up to the filesystem root", startDir: outside, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FindProjectRoot(tt.startDir)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("FindProjectRoot(%q) = %q, %v; want %q, %v", tt.startDir, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCurrentProjectDir(t *testing.T) {
+	root, err := filepath.EvalSymlinks(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	project := mkProject(t, root, "shop", "atempo.json")
+	nested := mkProject(t, root, "shop/src/routes")
+	outside := mkProject(t, root, "scratch")
+
+	previous, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(previous) })
+
+	for startDir, want := range map[string]string{nested: project, outside: outside} {
+		if err := os.Chdir(startDir); err != nil {
+			t.Fatal(err)
+		}
+		got, err := CurrentProjectDir()
+		if err != nil {
+			t.Fatalf("CurrentProjectDir: %v", err)
+		}
+		if got != want {
+			t.Errorf("CurrentProjectDir() in %q = %q, want %q", startDir, got, want)
+		}
+	}
+}